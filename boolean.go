@@ -0,0 +1,418 @@
+package mosaic
+
+import (
+	"math"
+	"sort"
+)
+
+// booleanTolerance snaps intersections this close to an edge's endpoint onto it
+const booleanTolerance = 1e-10
+
+type (
+	booleanOp int
+
+	fragment struct {
+		start Vector
+		end   Vector
+		// inside reports whether the fragment lies within the other polygon
+		inside bool
+		// shared is 1 when the other polygon has the same fragment in the
+		// same direction, -1 when reversed and 0 otherwise
+		shared int
+	}
+)
+
+const (
+	unionOp booleanOp = iota
+	intersectionOp
+	differenceOp
+	xorOp
+)
+
+// Union returns the area covered by either p or q. Both polygons need to be
+// simple but may be concave, the results share p's Position.
+func (p PolygonOf[T]) Union(q PolygonOf[T]) []RegionOf[T] {
+	return booleanOf(p, q, unionOp)
+}
+
+// Intersection returns the area covered by both p and q
+func (p PolygonOf[T]) Intersection(q PolygonOf[T]) []RegionOf[T] {
+	return booleanOf(p, q, intersectionOp)
+}
+
+// Difference returns the area of p that is not covered by q
+func (p PolygonOf[T]) Difference(q PolygonOf[T]) []RegionOf[T] {
+	return booleanOf(p, q, differenceOp)
+}
+
+// Xor returns the area covered by exactly one of p and q
+func (p PolygonOf[T]) Xor(q PolygonOf[T]) []RegionOf[T] {
+	return booleanOf(p, q, xorOp)
+}
+
+// booleanOf runs op in float64, the precision the snapping tolerance is tuned
+// for, and hands the regions back in T
+func booleanOf[T Float](p, q PolygonOf[T], op booleanOp) []RegionOf[T] {
+	return ConvertRegions[T](boolean(ConvertPolygon[float64](p), ConvertPolygon[float64](q), op))
+}
+
+// boolean splits both boundaries wherever they meet, keeps the fragments the
+// operation needs based on which side of the other polygon they are on and
+// links what is left back into rings
+func boolean(p, q Polygon, op booleanOp) []Region {
+	a := ccwRing(p.Edges)
+	b := ccwRing(q.Edges)
+	if len(a) < 3 || len(b) < 3 {
+		return []Region{}
+	}
+
+	fa, fb := splitRings(a, b)
+
+	segments := []Edge{}
+	keep := func(f fragment, reverse bool) {
+		if reverse {
+			segments = append(segments, Edge{Start: f.end, End: f.start, Active: true})
+			return
+		}
+		segments = append(segments, Edge{Start: f.start, End: f.end, Active: true})
+	}
+
+	for _, f := range fa {
+		switch {
+		case f.shared == 1:
+			if op == unionOp || op == intersectionOp {
+				keep(f, false)
+			}
+		case f.shared == -1:
+			if op == differenceOp || op == xorOp {
+				keep(f, false)
+			}
+		case f.inside:
+			if op == intersectionOp {
+				keep(f, false)
+			} else if op == xorOp {
+				keep(f, true)
+			}
+		default:
+			if op != intersectionOp {
+				keep(f, false)
+			}
+		}
+	}
+
+	for _, f := range fb {
+		switch {
+		case f.shared == 1:
+		case f.shared == -1:
+			if op == xorOp {
+				keep(f, false)
+			}
+		case f.inside:
+			if op == intersectionOp {
+				keep(f, false)
+			} else if op == differenceOp || op == xorOp {
+				keep(f, true)
+			}
+		default:
+			if op == unionOp || op == xorOp {
+				keep(f, false)
+			}
+		}
+	}
+
+	return buildRegions(p.Position, linkRings(segments))
+}
+
+// ccwRing returns the vertices of edges wound CCW
+func ccwRing(edges []Edge) []Vector {
+	ring := make([]Vector, 0, len(edges))
+	for _, edge := range edges {
+		ring = append(ring, edge.Start)
+	}
+
+	ring = removeCollinear(ring)
+	if signedArea(ring) < 0 {
+		reverseVectors(ring)
+	}
+
+	return ring
+}
+
+// splitRings cuts the edges of both rings at every point where they meet and
+// classifies the fragments against the other ring
+func splitRings(a, b []Vector) (fa, fb []fragment) {
+	type split struct {
+		t float64
+		v Vector
+	}
+
+	splitsA := make([][]split, len(a))
+	splitsB := make([][]split, len(b))
+
+	for i := range a {
+		a0, a1 := a[i], a[(i+1)%len(a)]
+
+		for j := range b {
+			b0, b1 := b[j], b[(j+1)%len(b)]
+
+			for _, x := range segmentSplits(a0, a1, b0, b1) {
+				splitsA[i] = append(splitsA[i], split{t: x.t, v: x.v})
+				splitsB[j] = append(splitsB[j], split{t: x.u, v: x.v})
+			}
+		}
+	}
+
+	fragments := func(ring []Vector, splits [][]split) []fragment {
+		result := []fragment{}
+		for i := range ring {
+			points := append(splits[i], split{t: 0, v: ring[i]}, split{t: 1, v: ring[(i+1)%len(ring)]})
+			sort.SliceStable(points, func(x, y int) bool {
+				return points[x].t < points[y].t
+			})
+
+			for k := 1; k < len(points); k++ {
+				if points[k].v == points[k-1].v {
+					continue
+				}
+				result = append(result, fragment{start: points[k-1].v, end: points[k].v})
+			}
+		}
+
+		return result
+	}
+
+	fa = fragments(a, splitsA)
+	fb = fragments(b, splitsB)
+
+	type key struct {
+		start Vector
+		end   Vector
+	}
+	indexB := make(map[key]int, len(fb))
+	for i, f := range fb {
+		indexB[key{f.start, f.end}] = i
+	}
+
+	for i := range fa {
+		if j, ok := indexB[key{fa[i].start, fa[i].end}]; ok {
+			fa[i].shared, fb[j].shared = 1, 1
+		} else if j, ok := indexB[key{fa[i].end, fa[i].start}]; ok {
+			fa[i].shared, fb[j].shared = -1, -1
+		}
+	}
+
+	for i := range fa {
+		fa[i].inside = ringContains(b, fa[i].start.Add(fa[i].end).Scale(0.5))
+	}
+	for i := range fb {
+		fb[i].inside = ringContains(a, fb[i].start.Add(fb[i].end).Scale(0.5))
+	}
+
+	return fa, fb
+}
+
+type segmentSplit struct {
+	t float64
+	u float64
+	v Vector
+}
+
+// segmentSplits finds where the segments a0, a1 and b0, b1 meet, t and u are
+// the parameters along each segment. Collinear overlaps split at the ends of
+// the overlap.
+func segmentSplits(a0, a1, b0, b1 Vector) []segmentSplit {
+	r := a1.Subtract(a0)
+	s := b1.Subtract(b0)
+	denominator := r.CrossProduct(s)
+	offset := b0.Subtract(a0)
+
+	if math.Abs(denominator) <= booleanTolerance*r.Magnitude()*s.Magnitude() {
+		if math.Abs(offset.CrossProduct(r)) > booleanTolerance*r.Magnitude()*offset.Magnitude() {
+			return nil
+		}
+
+		// Collinear, split each segment at the other's endpoints
+		splits := []segmentSplit{}
+		rr := r.DotProduct(r)
+		ss := s.DotProduct(s)
+		for _, v := range []Vector{b0, b1} {
+			t := v.Subtract(a0).DotProduct(r) / rr
+			if t > 0 && t < 1 {
+				splits = append(splits, segmentSplit{t: t, u: v.Subtract(b0).DotProduct(s) / ss, v: v})
+			}
+		}
+		for _, v := range []Vector{a0, a1} {
+			u := v.Subtract(b0).DotProduct(s) / ss
+			if u > 0 && u < 1 {
+				splits = append(splits, segmentSplit{t: v.Subtract(a0).DotProduct(r) / rr, u: u, v: v})
+			}
+		}
+
+		return splits
+	}
+
+	t := offset.CrossProduct(s) / denominator
+	u := offset.CrossProduct(r) / denominator
+	if t < -booleanTolerance || t > 1+booleanTolerance || u < -booleanTolerance || u > 1+booleanTolerance {
+		return nil
+	}
+
+	// Prefer existing vertices so both rings agree on the split point
+	v := a0.Add(r.Scale(t))
+	switch {
+	case t <= booleanTolerance:
+		t, v = 0, a0
+	case t >= 1-booleanTolerance:
+		t, v = 1, a1
+	}
+	switch {
+	case u <= booleanTolerance:
+		u, v = 0, b0
+	case u >= 1-booleanTolerance:
+		u, v = 1, b1
+	}
+
+	return []segmentSplit{{t: t, u: u, v: v}}
+}
+
+// ringContains is the crossing number test for a point off the boundary
+func ringContains(ring []Vector, v Vector) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Y > v.Y) != (b.Y > v.Y) &&
+			v.X < (b.X-a.X)*(v.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// linkRings chains directed segments into closed rings, taking the sharpest
+// left turn wherever several segments leave the same vertex so the rings
+// stay simple
+func linkRings(segments []Edge) [][]Vector {
+	outgoing := make(map[Vector][]int, len(segments))
+	for i, segment := range segments {
+		outgoing[segment.Start] = append(outgoing[segment.Start], i)
+	}
+
+	used := make([]bool, len(segments))
+	rings := [][]Vector{}
+
+	for i := range segments {
+		if used[i] {
+			continue
+		}
+
+		ring := []Vector{segments[i].Start}
+		used[i] = true
+		current := segments[i]
+		closed := false
+
+		for len(ring) <= len(segments) {
+			if current.End == segments[i].Start {
+				closed = true
+				break
+			}
+
+			ring = append(ring, current.End)
+			direction := current.End.Subtract(current.Start)
+
+			next := -1
+			bestAngle := -math.MaxFloat64
+			for _, k := range outgoing[current.End] {
+				if used[k] {
+					continue
+				}
+
+				out := segments[k].End.Subtract(segments[k].Start)
+				angle := math.Atan2(direction.CrossProduct(out), direction.DotProduct(out))
+				if angle > bestAngle {
+					bestAngle = angle
+					next = k
+				}
+			}
+
+			if next < 0 {
+				break
+			}
+
+			used[next] = true
+			current = segments[next]
+		}
+
+		if closed {
+			rings = append(rings, ring)
+		}
+	}
+
+	return rings
+}
+
+// buildRegions treats CCW rings as outer polygons and CW rings as holes of
+// the smallest outer ring around them
+func buildRegions(position Vector, rings [][]Vector) []Region {
+	outers := [][]Vector{}
+	holes := [][]Vector{}
+
+	for _, ring := range rings {
+		ring = removeCollinear(ring)
+		if len(ring) < 3 {
+			continue
+		}
+
+		area := signedArea(ring)
+		switch {
+		case area > 0:
+			outers = append(outers, ring)
+		case area < 0:
+			reverseVectors(ring)
+			holes = append(holes, ring)
+		}
+	}
+
+	owned := make([][]Polygon, len(outers))
+	for _, hole := range holes {
+		owner := -1
+		for i, outer := range outers {
+			if !ringContains(outer, ringInterior(hole)) {
+				continue
+			}
+
+			if owner < 0 || signedArea(outer) < signedArea(outers[owner]) {
+				owner = i
+			}
+		}
+
+		if owner >= 0 {
+			owned[owner] = append(owned[owner], NewPolygon(position, relativeTo(hole, position)))
+		}
+	}
+
+	regions := make([]Region, len(outers))
+	for i, outer := range outers {
+		regions[i] = NewRegion(NewPolygon(position, relativeTo(outer, position)), owned[i]...)
+	}
+
+	return regions
+}
+
+// ringInterior returns a point just inside of a CCW ring, next to its first edge
+func ringInterior(ring []Vector) Vector {
+	a, b := ring[0], ring[1]
+	midpoint := a.Add(b).Scale(0.5)
+	inward := b.Subtract(a).Perpendicular().Invert().Normalize()
+
+	return midpoint.Add(inward.Scale(a.Distance(b) * 1e-6))
+}
+
+func relativeTo[T Float](vectors []VectorOf[T], position VectorOf[T]) []VectorOf[T] {
+	relative := make([]VectorOf[T], len(vectors))
+	for i, v := range vectors {
+		relative[i] = v.Subtract(position)
+	}
+
+	return relative
+}
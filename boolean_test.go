@@ -0,0 +1,130 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_Boolean(t *testing.T) {
+	u := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(6, 0),
+			mosaic.NewVector(6, 6),
+			mosaic.NewVector(4, 6),
+			mosaic.NewVector(4, 2),
+			mosaic.NewVector(2, 2),
+			mosaic.NewVector(2, 6),
+			mosaic.NewVector(0, 6),
+		},
+	)
+	bar := mosaic.NewRectangle(mosaic.NewVector(3, 5), 6, 2).ToPolygon()
+
+	type input struct {
+		p  mosaic.Polygon
+		q  mosaic.Polygon
+		op func(p, q mosaic.Polygon) []mosaic.Region
+	}
+	type want struct {
+		regions int
+		holes   int
+		area    float64
+	}
+	union := func(p, q mosaic.Polygon) []mosaic.Region { return p.Union(q) }
+	intersection := func(p, q mosaic.Polygon) []mosaic.Region { return p.Intersection(q) }
+	difference := func(p, q mosaic.Polygon) []mosaic.Region { return p.Difference(q) }
+	xor := func(p, q mosaic.Polygon) []mosaic.Region { return p.Xor(q) }
+
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "union of overlapping squares",
+			input: input{p: square(0, 0, 4), q: square(2, 2, 4), op: union},
+			want:  want{regions: 1, area: 28},
+		},
+		{
+			name:  "intersection of overlapping squares",
+			input: input{p: square(0, 0, 4), q: square(2, 2, 4), op: intersection},
+			want:  want{regions: 1, area: 4},
+		},
+		{
+			name:  "difference of overlapping squares",
+			input: input{p: square(0, 0, 4), q: square(2, 2, 4), op: difference},
+			want:  want{regions: 1, area: 12},
+		},
+		{
+			name:  "xor of overlapping squares",
+			input: input{p: square(0, 0, 4), q: square(2, 2, 4), op: xor},
+			want:  want{regions: 2, area: 24},
+		},
+		{
+			name:  "union of disjoint squares",
+			input: input{p: square(0, 0, 4), q: square(10, 0, 4), op: union},
+			want:  want{regions: 2, area: 32},
+		},
+		{
+			name:  "intersection of disjoint squares",
+			input: input{p: square(0, 0, 4), q: square(10, 0, 4), op: intersection},
+			want:  want{regions: 0, area: 0},
+		},
+		{
+			name:  "difference punching a hole",
+			input: input{p: square(0, 0, 10), q: square(1, 1, 2), op: difference},
+			want:  want{regions: 1, holes: 1, area: 96},
+		},
+		{
+			name:  "union closing a hole",
+			input: input{p: u, q: bar, op: union},
+			want:  want{regions: 1, holes: 1, area: 32},
+		},
+		{
+			name:  "difference splitting a concave polygon",
+			input: input{p: u, q: mosaic.NewRectangle(mosaic.NewVector(3, 1.5), 8, 3).ToPolygon(), op: difference},
+			want:  want{regions: 2, area: 12},
+		},
+		{
+			name:  "difference cutting both arms",
+			input: input{p: u, q: bar, op: difference},
+			want:  want{regions: 1, area: 20},
+		},
+		{
+			name:  "intersection with a concave polygon",
+			input: input{p: u, q: bar, op: intersection},
+			want:  want{regions: 2, area: 8},
+		},
+		{
+			name:  "union of squares sharing an edge",
+			input: input{p: square(0, 0, 2), q: square(2, 0, 2), op: union},
+			want:  want{regions: 1, area: 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.op(tt.input.p, tt.input.q)
+
+			if len(got) != tt.want.regions {
+				t.Fatalf("polygon boolean regions = %v, want %v", len(got), tt.want.regions)
+			}
+
+			holes := 0
+			area := 0.0
+			for _, region := range got {
+				holes += len(region.Holes)
+				area += region.Area()
+			}
+
+			if holes != tt.want.holes {
+				t.Errorf("polygon boolean holes = %v, want %v", holes, tt.want.holes)
+			}
+
+			if !WithinTolerance(area, tt.want.area, 1e-9) {
+				t.Errorf("polygon boolean area = %v, want %v", area, tt.want.area)
+			}
+		})
+	}
+}
@@ -1,32 +1,75 @@
 package mosaic
 
+import "math"
+
 type (
-	Circle struct {
-		Position Vector
-		Radius   float64
-		Bounds   Rectangle
+	CircleOf[T Float] struct {
+		Position VectorOf[T]
+		Radius   T
+		// Rotation in degrees does not change the outline, it is kept for
+		// whatever is drawn or attached to the circle
+		Rotation T
+		bounds   RectangleOf[T]
 	}
+
+	Circle = CircleOf[float64]
 )
 
-func NewCircle(position Vector, radius float64) Circle {
-	return Circle{
+func NewCircle[T Float](position VectorOf[T], radius T) CircleOf[T] {
+	return CircleOf[T]{
 		Position: position,
 		Radius:   radius,
-		Bounds:   NewRectangle(position, radius, radius),
-	}
+	}.Update()
 }
 
-func (c Circle) Update() Circle {
-	c.Bounds = NewRectangle(c.Position, c.Radius, c.Radius)
+// ConvertCircle changes the precision of c
+func ConvertCircle[U, T Float](c CircleOf[T]) CircleOf[U] {
+	return CircleOf[U]{
+		Position: ConvertVector[U](c.Position),
+		Radius:   U(c.Radius),
+		Rotation: U(c.Rotation),
+	}.Update()
+}
+
+func (c CircleOf[T]) Update() CircleOf[T] {
+	c.bounds = NewRectangle(c.Position, 2*c.Radius, 2*c.Radius)
 	return c
 }
 
-func (c Circle) Intersects(d Circle) (normal Vector, depth float64) {
+// Transform moves c by the translation of t, then turns it about its
+// Position by the rotation of t and scales the radius
+func (c CircleOf[T]) Transform(t Transform) CircleOf[T] {
+	c.Position = c.Position.Add(ConvertVector[T](t.Position()))
+	c.Rotation = T(math.Remainder(float64(c.Rotation)+t.Angle(), 360))
+	c.Radius = c.Radius * T(t.Scale())
+
+	return c.Update()
+}
+
+// Rotate turns c about its Position by angle in degrees
+func (c CircleOf[T]) Rotate(angle T) CircleOf[T] {
+	c.Rotation = T(math.Remainder(float64(c.Rotation+angle), 360))
+	return c
+}
+
+func (c CircleOf[T]) Type() ShapeType {
+	return CircleShape
+}
+
+func (c CircleOf[T]) Bounds() RectangleOf[T] {
+	return c.bounds
+}
+
+func (c CircleOf[T]) Support(direction VectorOf[T]) VectorOf[T] {
+	return c.Position.Add(direction.Normalize().Scale(c.Radius))
+}
+
+func (c CircleOf[T]) Intersects(d CircleOf[T]) (normal VectorOf[T], depth T) {
 	distance := c.Position.Distance(d.Position)
 	radii := c.Radius + d.Radius
 
 	if distance >= radii {
-		return Vector{}, 0.0
+		return VectorOf[T]{}, 0.0
 	}
 
 	normal = d.Position.Subtract(c.Position).Normalize()
@@ -35,6 +78,103 @@ func (c Circle) Intersects(d Circle) (normal Vector, depth float64) {
 	return normal, depth
 }
 
-func (c Circle) Contains(d Circle) bool {
+// IntersectsPolygon expects p to be convex, the normal points from c to p
+func (c CircleOf[T]) IntersectsPolygon(p PolygonOf[T]) (normal VectorOf[T], depth T) {
+	return circleIntersectsEdges(c, p.Edges, p.Planes)
+}
+
+// IntersectsRectangle returns a normal pointing from c to r
+func (c CircleOf[T]) IntersectsRectangle(r RectangleOf[T]) (normal VectorOf[T], depth T) {
+	return circleIntersectsEdges(c, r.Edges[:], r.Planes[:])
+}
+
+// CastRay ignores rays that start inside of c, the hit is worked out in
+// float64
+func (c CircleOf[T]) CastRay(r Ray) RayHit {
+	return castRayCircle(ConvertCircle[float64](c), r)
+}
+
+func castRayCircle(c Circle, r Ray) RayHit {
+	if r.Distance <= 0 {
+		return RayHit{}
+	}
+
+	offset := r.Origin.Subtract(c.Position)
+	if offset.Magnitude() < c.Radius {
+		return RayHit{}
+	}
+
+	t, ok := sweepCircleTime(offset, r.Direction.Scale(r.Distance), c.Radius)
+	if !ok {
+		return RayHit{}
+	}
+
+	point := r.At(t)
+	return RayHit{
+		Hit:      true,
+		Point:    point,
+		Normal:   point.Subtract(c.Position).Normalize(),
+		Fraction: t,
+	}
+}
+
+func (c CircleOf[T]) Contains(d CircleOf[T]) bool {
 	return c.Radius >= c.Position.Distance(d.Position)+d.Radius
 }
+
+// circleIntersectsEdges is SAT between a circle and a convex set of edges
+// using every active plane and the axis to the closest vertex. The normal
+// points from the circle towards the centroid of the edges.
+func circleIntersectsEdges[T Float](
+	c CircleOf[T],
+	edges []EdgeOf[T],
+	planes []PlaneOf[T],
+) (normal VectorOf[T], depth T) {
+	if len(edges) == 0 {
+		return VectorOf[T]{}, 0.0
+	}
+
+	depth = T(math.Inf(1))
+	closest := edges[0].Start
+	for _, edge := range edges {
+		if c.Position.Distance(edge.Start) < c.Position.Distance(closest) {
+			closest = edge.Start
+		}
+	}
+
+	axes := make([]VectorOf[T], 0, len(planes)+1)
+	for i, plane := range planes {
+		if edges[i].Active {
+			axes = append(axes, plane.Normal)
+		}
+	}
+	if closest != c.Position {
+		axes = append(axes, closest.Subtract(c.Position).Normalize())
+	}
+
+	for _, axis := range axes {
+		minP, maxP := projectEdges(edges, axis)
+		center := c.Position.DotProduct(axis)
+		minC, maxC := center-c.Radius, center+c.Radius
+
+		if minP >= maxC || minC >= maxP {
+			return VectorOf[T]{}, 0.0
+		}
+
+		axisDistance := min(maxC-minP, maxP-minC)
+		if axisDistance < depth {
+			depth = axisDistance
+			normal = axis
+		}
+	}
+
+	if math.IsInf(float64(depth), 1) {
+		return VectorOf[T]{}, 0.0
+	}
+
+	if normal.DotProduct(edgeCentroid(edges).Subtract(c.Position)) < 0 {
+		normal = normal.Invert()
+	}
+
+	return normal, depth
+}
@@ -0,0 +1,194 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_circle_IntersectsRectangle(t *testing.T) {
+	type setup struct {
+		circle mosaic.Circle
+	}
+	type input struct {
+		rectangle mosaic.Rectangle
+	}
+	type want struct {
+		normal mosaic.Vector
+		depth  float64
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		input input
+		want  want
+	}{
+		{
+			name: "face",
+			setup: setup{
+				circle: mosaic.NewCircle(mosaic.NewVector(0, 3), 2),
+			},
+			input: input{
+				rectangle: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 0, Y: -1},
+				depth:  1,
+			},
+		},
+		{
+			name: "corner",
+			setup: setup{
+				circle: mosaic.NewCircle(mosaic.NewVector(3, 3), 2),
+			},
+			input: input{
+				rectangle: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+			},
+			want: want{
+				normal: mosaic.Vector{X: -0.7071067811865475, Y: -0.7071067811865475},
+				depth:  0.5857864376269051,
+			},
+		},
+		{
+			name: "near the corner but apart",
+			setup: setup{
+				circle: mosaic.NewCircle(mosaic.NewVector(3.6, 3.6), 2),
+			},
+			input: input{
+				rectangle: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+			},
+			want: want{
+				normal: mosaic.Vector{},
+				depth:  0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := struct {
+				normal mosaic.Vector
+				depth  float64
+			}{}
+			got.normal, got.depth = tt.setup.circle.IntersectsRectangle(tt.input.rectangle)
+
+			if got.normal != tt.want.normal {
+				t.Errorf("circle.IntersectsRectangle() normal = %v, want %v", got.normal, tt.want.normal)
+			}
+
+			if got.depth != tt.want.depth {
+				t.Errorf("circle.IntersectsRectangle() depth = %v, want %v", got.depth, tt.want.depth)
+			}
+		})
+	}
+}
+
+func Test_circle_IntersectsPolygon(t *testing.T) {
+	type setup struct {
+		circle mosaic.Circle
+	}
+	type input struct {
+		polygon mosaic.Polygon
+	}
+	type want struct {
+		normal mosaic.Vector
+		depth  float64
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		input input
+		want  want
+	}{
+		{
+			name: "face",
+			setup: setup{
+				circle: mosaic.NewCircle(mosaic.NewVector(1, -1), 2),
+			},
+			input: input{
+				polygon: mosaic.NewPolygon(
+					mosaic.NewVector(0, 0),
+					[]mosaic.Vector{
+						mosaic.NewVector(-4, 0),
+						mosaic.NewVector(4, 0),
+						mosaic.NewVector(0, 4),
+					},
+				),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 0, Y: 1},
+				depth:  1,
+			},
+		},
+		{
+			name: "vertex",
+			setup: setup{
+				circle: mosaic.NewCircle(mosaic.NewVector(0, 5), 2),
+			},
+			input: input{
+				polygon: mosaic.NewPolygon(
+					mosaic.NewVector(0, 0),
+					[]mosaic.Vector{
+						mosaic.NewVector(-4, 0),
+						mosaic.NewVector(4, 0),
+						mosaic.NewVector(0, 4),
+					},
+				),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 0, Y: -1},
+				depth:  1,
+			},
+		},
+		{
+			name: "vertices away from position",
+			setup: setup{
+				circle: mosaic.NewCircle(mosaic.NewVector(9, 1), 2),
+			},
+			input: input{
+				polygon: mosaic.NewPolygon(
+					mosaic.NewVector(0, 0),
+					[]mosaic.Vector{
+						mosaic.NewVector(10, 0),
+						mosaic.NewVector(12, 0),
+						mosaic.NewVector(12, 2),
+						mosaic.NewVector(10, 2),
+					},
+				),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 1, Y: 0},
+				depth:  1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := struct {
+				normal mosaic.Vector
+				depth  float64
+			}{}
+			got.normal, got.depth = tt.setup.circle.IntersectsPolygon(tt.input.polygon)
+
+			if got.normal != tt.want.normal {
+				t.Errorf("circle.IntersectsPolygon() normal = %v, want %v", got.normal, tt.want.normal)
+			}
+
+			if got.depth != tt.want.depth {
+				t.Errorf("circle.IntersectsPolygon() depth = %v, want %v", got.depth, tt.want.depth)
+			}
+		})
+	}
+}
+
+func Test_circle_Transform(t *testing.T) {
+	c := mosaic.NewCircle(mosaic.NewVector(1, 1), 2)
+
+	got := c.Transform(mosaic.NewTransform(1, -1, 1.5, 270))
+	if got.Position != mosaic.NewVector(2, 0) || got.Radius != 3 || !WithinTolerance(got.Rotation, -90, 0.0001) {
+		t.Errorf("circle.Transform() got = %v %v %v, want %v %v %v", got.Position, got.Radius, got.Rotation, mosaic.NewVector(2, 0), 3, -90)
+	}
+
+	if got.Bounds().Width() != 6 || got.Bounds().Position != mosaic.NewVector(2, 0) {
+		t.Errorf("circle.Transform() bounds = %v, want %v", got.Bounds().Width(), 6)
+	}
+}
@@ -0,0 +1,100 @@
+package mosaic
+
+type Classification int
+
+const (
+	Outside Classification = iota
+	Inside
+	OnBoundary
+)
+
+// Winding is how often e winds around v, +1 crossing upwards with v on the
+// left and -1 crossing downwards with v on the right
+func (e EdgeOf[T]) Winding(v VectorOf[T]) int {
+	a, b := e.Start, e.End
+	if a.Y <= v.Y {
+		if b.Y > v.Y && turn(a, b, v) > 0 {
+			return 1
+		}
+	} else if b.Y <= v.Y && turn(a, b, v) < 0 {
+		return -1
+	}
+
+	return 0
+}
+
+// OnBoundary reports whether v lies on e or within tolerance of it
+func (e EdgeOf[T]) OnBoundary(v VectorOf[T], tolerance T) bool {
+	if turn(e.Start, e.End, v) == 0 && onSegment(e.Start, e.End, v) {
+		return true
+	}
+
+	return e.DistanceTo(v) <= tolerance
+}
+
+// Classify places v against the outline of c
+func (c CircleOf[T]) Classify(v VectorOf[T], tolerance T) Classification {
+	distance := c.SignedDistance(v)
+	switch {
+	case distance >= -tolerance && distance <= tolerance:
+		return OnBoundary
+	case distance < 0:
+		return Inside
+	}
+
+	return Outside
+}
+
+// Classify places v against r by winding number, points within tolerance of
+// an edge are OnBoundary
+func (r RectangleOf[T]) Classify(v VectorOf[T], tolerance T) Classification {
+	return classifyEdges(r.Edges[:], v, tolerance)
+}
+
+// Classify places v against t by winding number, points within tolerance of
+// an edge are OnBoundary
+func (t TriangleOf[T]) Classify(v VectorOf[T], tolerance T) Classification {
+	return classifyEdges(t.Edges[:], v, tolerance)
+}
+
+// Classify places v against p by winding number, points within tolerance of
+// an edge are OnBoundary. Either winding works.
+func (p PolygonOf[T]) Classify(v VectorOf[T], tolerance T) Classification {
+	return classifyEdges(p.Edges, v, tolerance)
+}
+
+// Classify treats the outlines of holes as part of the boundary of r
+func (r RegionOf[T]) Classify(v VectorOf[T], tolerance T) Classification {
+	outer := r.Outer.Classify(v, tolerance)
+	if outer != Inside {
+		return outer
+	}
+
+	for _, hole := range r.Holes {
+		switch hole.Classify(v, tolerance) {
+		case Inside:
+			return Outside
+		case OnBoundary:
+			return OnBoundary
+		}
+	}
+
+	return Inside
+}
+
+func classifyEdges[T Float](edges []EdgeOf[T], v VectorOf[T], tolerance T) Classification {
+	winding := 0
+	for _, edge := range edges {
+		if edge.OnBoundary(v, tolerance) {
+			return OnBoundary
+		}
+
+		winding += edge.Winding(v)
+	}
+
+	if winding != 0 {
+		return Inside
+	}
+
+	return Outside
+}
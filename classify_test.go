@@ -0,0 +1,132 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_shape_Classify(t *testing.T) {
+	type classifier interface {
+		Classify(v mosaic.Vector, tolerance float64) mosaic.Classification
+	}
+	diamond := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, -2),
+			mosaic.NewVector(2, 0),
+			mosaic.NewVector(0, 2),
+			mosaic.NewVector(-2, 0),
+		},
+	)
+	type input struct {
+		shape     classifier
+		v         mosaic.Vector
+		tolerance float64
+	}
+	type want struct {
+		class mosaic.Classification
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "polygon inside",
+			input: input{shape: square(0, 0, 2), v: mosaic.NewVector(0.5, 0)},
+			want:  want{class: mosaic.Inside},
+		},
+		{
+			name:  "polygon outside",
+			input: input{shape: square(0, 0, 2), v: mosaic.NewVector(3, 0)},
+			want:  want{class: mosaic.Outside},
+		},
+		{
+			name:  "polygon vertex",
+			input: input{shape: square(0, 0, 2), v: mosaic.NewVector(1, 1)},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "polygon edge",
+			input: input{shape: square(0, 0, 2), v: mosaic.NewVector(1, 0.3)},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "outside level with a vertex",
+			input: input{shape: diamond, v: mosaic.NewVector(-3, 0)},
+			want:  want{class: mosaic.Outside},
+		},
+		{
+			name:  "inside level with a vertex",
+			input: input{shape: diamond, v: mosaic.NewVector(1, 0)},
+			want:  want{class: mosaic.Inside},
+		},
+		{
+			name:  "diagonal edge",
+			input: input{shape: diamond, v: mosaic.NewVector(1, 1)},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "within tolerance",
+			input: input{shape: square(0, 0, 2), v: mosaic.NewVector(1.05, 0), tolerance: 0.1},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "clockwise rectangle inside",
+			input: input{shape: mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2), v: mosaic.NewVector(0, 0.5)},
+			want:  want{class: mosaic.Inside},
+		},
+		{
+			name:  "rectangle corner",
+			input: input{shape: mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2), v: mosaic.NewVector(-1, -1)},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name: "triangle edge",
+			input: input{
+				shape: mosaic.NewTriangle(
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(2, 0),
+					mosaic.NewVector(0, 2),
+				),
+				v: mosaic.NewVector(1, 1),
+			},
+			want: want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "circle boundary",
+			input: input{shape: mosaic.NewCircle(mosaic.NewVector(0, 0), 1), v: mosaic.NewVector(0, -1)},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "circle inside",
+			input: input{shape: mosaic.NewCircle(mosaic.NewVector(0, 0), 1), v: mosaic.NewVector(0.5, 0)},
+			want:  want{class: mosaic.Inside},
+		},
+		{
+			name:  "region hole",
+			input: input{shape: mosaic.NewRegion(square(0, 0, 6), square(0, 0, 2)), v: mosaic.NewVector(0, 0)},
+			want:  want{class: mosaic.Outside},
+		},
+		{
+			name:  "region hole boundary",
+			input: input{shape: mosaic.NewRegion(square(0, 0, 6), square(0, 0, 2)), v: mosaic.NewVector(1, 0)},
+			want:  want{class: mosaic.OnBoundary},
+		},
+		{
+			name:  "region inside",
+			input: input{shape: mosaic.NewRegion(square(0, 0, 6), square(0, 0, 2)), v: mosaic.NewVector(2, 0)},
+			want:  want{class: mosaic.Inside},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.shape.Classify(tt.input.v, tt.input.tolerance)
+			if got != tt.want.class {
+				t.Errorf("shape.Classify() got = %v, want %v", got, tt.want.class)
+			}
+		})
+	}
+}
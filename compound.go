@@ -0,0 +1,138 @@
+package mosaic
+
+import "math"
+
+type (
+	// CompoundOf is a concave polygon split into convex pieces for SAT
+	CompoundOf[T Float] struct {
+		Position VectorOf[T]
+		Pieces   []PolygonOf[T]
+		bounds   RectangleOf[T]
+	}
+
+	Compound = CompoundOf[float64]
+)
+
+func NewCompound[T Float](p PolygonOf[T]) CompoundOf[T] {
+	return CompoundOf[T]{
+		Position: p.Position,
+		Pieces:   p.Decompose(),
+	}.Update()
+}
+
+// ConvertCompound changes the precision of c, a compound that is already in U
+// is returned as is
+func ConvertCompound[U, T Float](c CompoundOf[T]) CompoundOf[U] {
+	if same, ok := any(c).(CompoundOf[U]); ok {
+		return same
+	}
+
+	return CompoundOf[U]{
+		Position: ConvertVector[U](c.Position),
+		Pieces:   ConvertPolygons[U](c.Pieces),
+	}.Update()
+}
+
+func (c CompoundOf[T]) Update() CompoundOf[T] {
+	edges := []EdgeOf[T]{}
+	for i := range c.Pieces {
+		c.Pieces[i] = c.Pieces[i].SetPosition(c.Position)
+		edges = append(edges, c.Pieces[i].Edges...)
+	}
+	c.bounds = edgeBounds(edges)
+
+	return c
+}
+
+func (c CompoundOf[T]) Type() ShapeType {
+	return CompoundShape
+}
+
+func (c CompoundOf[T]) Bounds() RectangleOf[T] {
+	return c.bounds
+}
+
+func (c CompoundOf[T]) SetPosition(position VectorOf[T]) CompoundOf[T] {
+	if c.Position == position {
+		return c
+	}
+
+	pieces := make([]PolygonOf[T], len(c.Pieces))
+	for i, piece := range c.Pieces {
+		pieces[i] = piece.Clone()
+	}
+	c.Pieces = pieces
+	c.Position = position
+
+	return c.Update()
+}
+
+func (c CompoundOf[T]) ContainsVector(v VectorOf[T]) bool {
+	for _, piece := range c.Pieces {
+		if piece.ContainsVector(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c CompoundOf[T]) Area() T {
+	area := T(0)
+	for _, piece := range c.Pieces {
+		area += piece.Area()
+	}
+
+	return area
+}
+
+// Intersects returns the deepest collision between any piece of c and s, the
+// normal points from c to s. It is worked out in float64 like Collide.
+func (c CompoundOf[T]) Intersects(s Shape) (normal Vector, depth float64) {
+	for _, piece := range ConvertPolygons[float64](c.Pieces) {
+		n, d := Collide(piece, s)
+		if d > depth {
+			normal, depth = n, d
+		}
+	}
+
+	return normal, depth
+}
+
+// CastRay returns the closest hit against any piece
+func (c CompoundOf[T]) CastRay(ray Ray) RayHit {
+	closest := RayHit{Fraction: math.MaxFloat64}
+
+	for _, piece := range c.Pieces {
+		hit := piece.CastRay(ray)
+		if hit.Hit && hit.Fraction < closest.Fraction {
+			closest = hit
+		}
+	}
+
+	if !closest.Hit {
+		return RayHit{}
+	}
+
+	return closest
+}
+
+// Sweep moves c by dc and s by ds and returns the earliest impact against any
+// piece, the normal points from c to s. It is worked out in float64 like
+// Sweep.
+func (c CompoundOf[T]) Sweep(dc Vector, s Shape, ds Vector) Impact {
+	earliest := Impact{Time: math.MaxFloat64}
+
+	for _, piece := range ConvertPolygons[float64](c.Pieces) {
+		impact := Sweep(piece, dc, s, ds)
+		if impact.Hit && impact.Time < earliest.Time {
+			earliest = impact
+		}
+	}
+
+	if !earliest.Hit {
+		return Impact{}
+	}
+
+	return earliest
+}
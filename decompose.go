@@ -0,0 +1,330 @@
+package mosaic
+
+import "math"
+
+const (
+	// decomposeDepth bounds the recursion of Bayazit's algorithm on bad input
+	decomposeDepth = 256
+	// collinearTolerance is the sine of the smallest angle treated as a turn
+	collinearTolerance = 1e-9
+)
+
+// Decompose splits a simple polygon into convex polygons that share p's
+// Position and Rotation using Bayazit's algorithm, neighbouring pieces are then merged
+// back together while they stay convex. Convex polygons are returned as is.
+func (p PolygonOf[T]) Decompose() []PolygonOf[T] {
+	vectors := make([]Vector, 0, len(p.rawEdges))
+	for _, edge := range p.rawEdges {
+		vectors = append(vectors, ConvertVector[float64](edge.Start))
+	}
+
+	vectors = removeCollinear(vectors)
+	if len(vectors) < 3 {
+		return []PolygonOf[T]{}
+	}
+
+	if signedArea(vectors) < 0 {
+		reverseVectors(vectors)
+	}
+
+	pieces := mergeConvex(bayazit(vectors, nil, 0))
+	polygons := make([]PolygonOf[T], len(pieces))
+	for i, piece := range pieces {
+		polygons[i] = p.reshape(ConvertVectors[T](piece))
+	}
+
+	return polygons
+}
+
+// IsConvex reports whether every vertex of p turns the same way
+func (p PolygonOf[T]) IsConvex() bool {
+	vectors := make([]Vector, len(p.rawEdges))
+	for i, edge := range p.rawEdges {
+		vectors[i] = ConvertVector[float64](edge.Start)
+	}
+
+	return isConvex(vectors)
+}
+
+func bayazit(polygon []Vector, pieces [][]Vector, depth int) [][]Vector {
+	n := len(polygon)
+	if n < 3 {
+		return pieces
+	}
+
+	if depth > decomposeDepth {
+		return append(pieces, polygon)
+	}
+
+	at := func(i int) Vector {
+		return polygon[((i%n)+n)%n]
+	}
+
+	for i := 0; i < n; i++ {
+		if !isReflex(at(i-1), at(i), at(i+1)) {
+			continue
+		}
+
+		upperDistance, lowerDistance := math.MaxFloat64, math.MaxFloat64
+		upperIndex, lowerIndex := 0, 0
+		var upperIntersect, lowerIntersect Vector
+
+		for j := 0; j < n; j++ {
+			if turn(at(i-1), at(i), at(j)) > 0 && turn(at(i-1), at(i), at(j-1)) <= 0 {
+				v, ok := lineIntersect(at(i-1), at(i), at(j), at(j-1))
+				if ok && turn(at(i+1), at(i), v) < 0 {
+					d := at(i).Subtract(v).Length()
+					if d < lowerDistance {
+						lowerDistance = d
+						lowerIntersect = v
+						lowerIndex = j
+					}
+				}
+			}
+
+			if turn(at(i+1), at(i), at(j+1)) > 0 && turn(at(i+1), at(i), at(j)) <= 0 {
+				v, ok := lineIntersect(at(i+1), at(i), at(j), at(j+1))
+				if ok && turn(at(i-1), at(i), v) > 0 {
+					d := at(i).Subtract(v).Length()
+					if d < upperDistance {
+						upperDistance = d
+						upperIntersect = v
+						upperIndex = j
+					}
+				}
+			}
+		}
+
+		var lower, upper []Vector
+		if lowerIndex == (upperIndex+1)%n {
+			// No vertex to connect to, split at the middle of the two intersections
+			v := lowerIntersect.Add(upperIntersect).Scale(0.5)
+
+			if i < upperIndex {
+				lower = append(lower, polygon[i:upperIndex+1]...)
+				lower = append(lower, v)
+				upper = append(upper, v)
+				if lowerIndex != 0 {
+					upper = append(upper, polygon[lowerIndex:]...)
+				}
+				upper = append(upper, polygon[:i+1]...)
+			} else {
+				if i != 0 {
+					lower = append(lower, polygon[i:]...)
+				}
+				lower = append(lower, polygon[:upperIndex+1]...)
+				lower = append(lower, v)
+				upper = append(upper, v)
+				upper = append(upper, polygon[lowerIndex:i+1]...)
+			}
+		} else {
+			// Connect to the closest visible vertex between the intersections
+			if lowerIndex > upperIndex {
+				upperIndex += n
+			}
+
+			closestDistance := math.MaxFloat64
+			closestIndex := -1
+			for j := lowerIndex; j <= upperIndex; j++ {
+				if turn(at(i-1), at(i), at(j)) >= 0 && turn(at(i+1), at(i), at(j)) <= 0 {
+					d := at(i).Subtract(at(j)).Length()
+					if d < closestDistance && canSee(polygon, i, j%n) {
+						closestDistance = d
+						closestIndex = j % n
+					}
+				}
+			}
+
+			if closestIndex < 0 {
+				continue
+			}
+
+			if i < closestIndex {
+				lower = append(lower, polygon[i:closestIndex+1]...)
+				if closestIndex != 0 {
+					upper = append(upper, polygon[closestIndex:]...)
+				}
+				upper = append(upper, polygon[:i+1]...)
+			} else {
+				if i != 0 {
+					lower = append(lower, polygon[i:]...)
+				}
+				lower = append(lower, polygon[:closestIndex+1]...)
+				upper = append(upper, polygon[closestIndex:i+1]...)
+			}
+		}
+
+		if len(lower) < len(upper) {
+			pieces = bayazit(lower, pieces, depth+1)
+			return bayazit(upper, pieces, depth+1)
+		}
+
+		pieces = bayazit(upper, pieces, depth+1)
+		return bayazit(lower, pieces, depth+1)
+	}
+
+	return append(pieces, polygon)
+}
+
+// mergeConvex joins pieces that share an edge when the result is convex
+func mergeConvex(pieces [][]Vector) [][]Vector {
+	for merged := true; merged; {
+		merged = false
+
+		for i := 0; i < len(pieces) && !merged; i++ {
+			for j := i + 1; j < len(pieces) && !merged; j++ {
+				union, ok := mergePieces(pieces[i], pieces[j])
+				if !ok || !isConvex(union) {
+					continue
+				}
+
+				pieces[i] = union
+				pieces = append(pieces[:j], pieces[j+1:]...)
+				merged = true
+			}
+		}
+	}
+
+	return pieces
+}
+
+// mergePieces joins two CCW pieces along an edge they share in opposite
+// directions
+func mergePieces(a, b []Vector) ([]Vector, bool) {
+	n, m := len(a), len(b)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			if a[i] != b[(j+1)%m] || a[(i+1)%n] != b[j] {
+				continue
+			}
+
+			union := make([]Vector, 0, n+m-2)
+			for k := 0; k < n; k++ {
+				union = append(union, a[(i+1+k)%n])
+			}
+			for k := 2; k < m; k++ {
+				union = append(union, b[(j+k)%m])
+			}
+
+			return removeCollinear(union), true
+		}
+	}
+
+	return nil, false
+}
+
+// canSee reports whether the diagonal from i to j stays inside the polygon
+func canSee(polygon []Vector, i, j int) bool {
+	n := len(polygon)
+	at := func(k int) Vector {
+		return polygon[((k%n)+n)%n]
+	}
+
+	if i == j || (i+1)%n == j || (j+1)%n == i {
+		return false
+	}
+
+	for k := 0; k < n; k++ {
+		if k == i || k == j || (k+1)%n == i || (k+1)%n == j {
+			continue
+		}
+
+		if segmentsCross(at(i), at(j), at(k), at(k+1)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// turn is positive when c is left of the line from a to b
+func turn[T Float](a, b, c VectorOf[T]) T {
+	return b.Subtract(a).CrossProduct(c.Subtract(a))
+}
+
+// collinear reports whether the turn at b is too small to matter
+func collinear(a, b, c Vector) bool {
+	return math.Abs(turn(a, b, c)) <= collinearTolerance*a.Distance(b)*b.Distance(c)
+}
+
+func isReflex(prev, v, next Vector) bool {
+	return turn(prev, v, next) < 0 && !collinear(prev, v, next)
+}
+
+func isConvex(vectors []Vector) bool {
+	n := len(vectors)
+	sign := 0.0
+	for i := 0; i < n; i++ {
+		a, b, c := vectors[i], vectors[(i+1)%n], vectors[(i+2)%n]
+		t := turn(a, b, c)
+		if collinear(a, b, c) {
+			continue
+		}
+
+		if sign != 0 && math.Signbit(t) != math.Signbit(sign) {
+			return false
+		}
+		sign = t
+	}
+
+	return true
+}
+
+// lineIntersect intersects the infinite lines through a, b and c, d
+func lineIntersect(a, b, c, d Vector) (Vector, bool) {
+	r := b.Subtract(a)
+	s := d.Subtract(c)
+
+	denominator := r.CrossProduct(s)
+	if denominator == 0 {
+		return Vector{}, false
+	}
+
+	t := c.Subtract(a).CrossProduct(s) / denominator
+	return a.Add(r.Scale(t)), true
+}
+
+// segmentsCross reports a proper crossing of the segments a, b and c, d
+func segmentsCross(a, b, c, d Vector) bool {
+	d1 := turn(a, b, c)
+	d2 := turn(a, b, d)
+	d3 := turn(c, d, a)
+	d4 := turn(c, d, b)
+
+	return ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) &&
+		((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0))
+}
+
+// signedArea is positive for CCW vectors
+func signedArea(vectors []Vector) float64 {
+	area := 0.0
+	for i := 0; i < len(vectors); i++ {
+		area += vectors[i].CrossProduct(vectors[(i+1)%len(vectors)])
+	}
+
+	return area / 2
+}
+
+func reverseVectors(vectors []Vector) {
+	for i, j := 0, len(vectors)-1; i < j; i, j = i+1, j-1 {
+		vectors[i], vectors[j] = vectors[j], vectors[i]
+	}
+}
+
+func removeCollinear(vectors []Vector) []Vector {
+	result := make([]Vector, 0, len(vectors))
+	n := len(vectors)
+
+	for i := 0; i < n; i++ {
+		prev := vectors[(i-1+n)%n]
+		next := vectors[(i+1)%n]
+		if vectors[i] == prev || collinear(prev, vectors[i], next) {
+			continue
+		}
+
+		result = append(result, vectors[i])
+	}
+
+	return result
+}
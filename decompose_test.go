@@ -0,0 +1,170 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_Decompose(t *testing.T) {
+	tests := []struct {
+		name    string
+		polygon mosaic.Polygon
+		pieces  int
+	}{
+		{
+			name: "convex",
+			polygon: mosaic.NewRectangle(
+				mosaic.NewVector(0, 0), 4, 4,
+			).ToPolygon(),
+			pieces: 1,
+		},
+		{
+			name: "L shape",
+			polygon: mosaic.NewPolygon(
+				mosaic.NewVector(10, 10),
+				[]mosaic.Vector{
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(4, 0),
+					mosaic.NewVector(4, 1),
+					mosaic.NewVector(1, 1),
+					mosaic.NewVector(1, 4),
+					mosaic.NewVector(0, 4),
+				},
+			),
+			pieces: 2,
+		},
+		{
+			name: "comb",
+			polygon: mosaic.NewPolygon(
+				mosaic.NewVector(0, 0),
+				[]mosaic.Vector{
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(7, 0),
+					mosaic.NewVector(7, 4),
+					mosaic.NewVector(6, 4),
+					mosaic.NewVector(6, 1),
+					mosaic.NewVector(4, 1),
+					mosaic.NewVector(4, 4),
+					mosaic.NewVector(3, 4),
+					mosaic.NewVector(3, 1),
+					mosaic.NewVector(1, 1),
+					mosaic.NewVector(1, 4),
+					mosaic.NewVector(0, 4),
+				},
+			),
+			pieces: 4,
+		},
+		{
+			name: "clockwise star",
+			polygon: mosaic.NewPolygon(
+				mosaic.NewVector(0, 0),
+				[]mosaic.Vector{
+					mosaic.NewVector(0, 5),
+					mosaic.NewVector(1, 1),
+					mosaic.NewVector(5, 0),
+					mosaic.NewVector(1, -1),
+					mosaic.NewVector(0, -5),
+					mosaic.NewVector(-1, -1),
+					mosaic.NewVector(-5, 0),
+					mosaic.NewVector(-1, 1),
+				},
+			),
+			pieces: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.polygon.Decompose()
+
+			if len(got) != tt.pieces {
+				t.Errorf("polygon.Decompose() pieces = %v, want %v", len(got), tt.pieces)
+			}
+
+			area := 0.0
+			for _, piece := range got {
+				if !piece.IsConvex() {
+					t.Errorf("polygon.Decompose() piece %v is not convex", piece.Info())
+				}
+
+				if piece.Position != tt.polygon.Position {
+					t.Errorf("polygon.Decompose() position = %v, want %v", piece.Position, tt.polygon.Position)
+				}
+
+				area += piece.Area()
+			}
+
+			if math.Abs(area-tt.polygon.Area()) > 1e-9 {
+				t.Errorf("polygon.Decompose() area = %v, want %v", area, tt.polygon.Area())
+			}
+		})
+	}
+}
+
+func Test_compound_Collide(t *testing.T) {
+	l := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(4, 0),
+			mosaic.NewVector(4, 1),
+			mosaic.NewVector(1, 1),
+			mosaic.NewVector(1, 4),
+			mosaic.NewVector(0, 4),
+		},
+	)
+	tests := []struct {
+		name  string
+		shape mosaic.Shape
+		depth float64
+	}{
+		{
+			name:  "inside the notch",
+			shape: mosaic.NewRectangle(mosaic.NewVector(3, 3), 1, 1),
+			depth: 0,
+		},
+		{
+			name:  "overlapping the arm",
+			shape: mosaic.NewRectangle(mosaic.NewVector(3, 1.25), 1, 1),
+			depth: 0.25,
+		},
+		{
+			name:  "circle overlapping the arm",
+			shape: mosaic.NewCircle(mosaic.NewVector(1.5, 3), 1),
+			depth: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := mosaic.Collide(mosaic.NewCompound(l), tt.shape)
+			if math.Abs(got-tt.depth) > 1e-9 {
+				t.Errorf("mosaic.Collide() depth = %v, want %v", got, tt.depth)
+			}
+
+			_, got = mosaic.Collide(tt.shape, mosaic.NewCompound(l))
+			if math.Abs(got-tt.depth) > 1e-9 {
+				t.Errorf("mosaic.Collide() reversed depth = %v, want %v", got, tt.depth)
+			}
+		})
+	}
+}
+
+func Test_compound_Area(t *testing.T) {
+	l := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(4, 0),
+			mosaic.NewVector(4, 1),
+			mosaic.NewVector(1, 1),
+			mosaic.NewVector(1, 4),
+			mosaic.NewVector(0, 4),
+		},
+	)
+
+	got := mosaic.NewCompound(l).Area()
+	if math.Abs(got-7) > 1e-9 {
+		t.Errorf("compound.Area() got = %v, want %v", got, 7)
+	}
+}
@@ -0,0 +1,222 @@
+package mosaic
+
+import "math"
+
+// ClosestPoint is the point on e nearest to v
+func (e EdgeOf[T]) ClosestPoint(v VectorOf[T]) VectorOf[T] {
+	return closestSegmentPoint(v, e.Start, e.End)
+}
+
+func (e EdgeOf[T]) DistanceTo(v VectorOf[T]) T {
+	return v.Distance(e.ClosestPoint(v))
+}
+
+// DistanceToEdge is zero when the edges touch
+func (e EdgeOf[T]) DistanceToEdge(f EdgeOf[T]) T {
+	if segmentsTouch(e.Start, e.End, f.Start, f.End) {
+		return 0
+	}
+
+	return min(
+		e.DistanceTo(f.Start), e.DistanceTo(f.End),
+		f.DistanceTo(e.Start), f.DistanceTo(e.End),
+	)
+}
+
+// ClosestPoint is the point on the outline of c nearest to v
+func (c CircleOf[T]) ClosestPoint(v VectorOf[T]) VectorOf[T] {
+	direction := v.Subtract(c.Position)
+	if direction == (VectorOf[T]{}) {
+		direction = VectorOf[T]{X: 1}
+	}
+
+	return c.Position.Add(direction.Normalize().Scale(c.Radius))
+}
+
+// DistanceTo is zero for points inside c
+func (c CircleOf[T]) DistanceTo(v VectorOf[T]) T {
+	return max(0, c.SignedDistance(v))
+}
+
+// SignedDistance is negative for points inside c
+func (c CircleOf[T]) SignedDistance(v VectorOf[T]) T {
+	return v.Distance(c.Position) - c.Radius
+}
+
+// ClosestPoint is the point on the outline of r nearest to v
+func (r RectangleOf[T]) ClosestPoint(v VectorOf[T]) VectorOf[T] {
+	return closestEdgePoint(r.Edges[:], v)
+}
+
+// DistanceTo is zero for points inside r
+func (r RectangleOf[T]) DistanceTo(v VectorOf[T]) T {
+	return max(0, r.SignedDistance(v))
+}
+
+// SignedDistance is negative for points inside r
+func (r RectangleOf[T]) SignedDistance(v VectorOf[T]) T {
+	return signedEdgeDistance(r.Edges[:], v)
+}
+
+// ClosestPoint is the point on the outline of t nearest to v
+func (t TriangleOf[T]) ClosestPoint(v VectorOf[T]) VectorOf[T] {
+	return closestEdgePoint(t.Edges[:], v)
+}
+
+// DistanceTo is zero for points inside t
+func (t TriangleOf[T]) DistanceTo(v VectorOf[T]) T {
+	return max(0, t.SignedDistance(v))
+}
+
+// SignedDistance is negative for points inside t
+func (t TriangleOf[T]) SignedDistance(v VectorOf[T]) T {
+	return signedEdgeDistance(t.Edges[:], v)
+}
+
+// ClosestPoint is the point on the outline of p nearest to v
+func (p PolygonOf[T]) ClosestPoint(v VectorOf[T]) VectorOf[T] {
+	return closestEdgePoint(p.Edges, v)
+}
+
+// DistanceTo is zero for points inside p
+func (p PolygonOf[T]) DistanceTo(v VectorOf[T]) T {
+	return max(0, p.SignedDistance(v))
+}
+
+// SignedDistance is negative for points inside p
+func (p PolygonOf[T]) SignedDistance(v VectorOf[T]) T {
+	return signedEdgeDistance(p.Edges, v)
+}
+
+// Distance is the gap between two shapes, zero when they touch or overlap
+func Distance(a, b Shape) float64 {
+	if c, ok := a.(Compound); ok {
+		distance := math.MaxFloat64
+		for _, piece := range c.Pieces {
+			distance = math.Min(distance, Distance(piece, b))
+		}
+		return distance
+	}
+
+	if c, ok := b.(Compound); ok {
+		return Distance(c, a)
+	}
+
+	if c, ok := a.(Circle); ok {
+		if d, ok := b.(Circle); ok {
+			return math.Max(0, c.Position.Distance(d.Position)-c.Radius-d.Radius)
+		}
+
+		return math.Max(0, pointDistance(b, c.Position)-c.Radius)
+	}
+
+	if _, ok := b.(Circle); ok {
+		return Distance(b, a)
+	}
+
+	p, q := shapeEdges(a), shapeEdges(b)
+	if len(p) == 0 || len(q) == 0 {
+		return math.MaxFloat64
+	}
+
+	// One shape inside the other has no edges near enough to measure
+	if edgesContain(q, p[0].Start) || edgesContain(p, q[0].Start) {
+		return 0
+	}
+
+	distance := math.MaxFloat64
+	for _, e := range p {
+		for _, f := range q {
+			distance = math.Min(distance, e.DistanceToEdge(f))
+		}
+	}
+
+	return distance
+}
+
+// SignedDistance is the gap between two shapes, or the penetration depth as a
+// negative number when they overlap
+func SignedDistance(a, b Shape) float64 {
+	if _, depth := Collide(a, b); depth > 0 {
+		return -depth
+	}
+
+	return Distance(a, b)
+}
+
+// pointDistance is zero for points inside s
+func pointDistance(s Shape, v Vector) float64 {
+	if c, ok := s.(Circle); ok {
+		return c.DistanceTo(v)
+	}
+
+	return math.Max(0, signedEdgeDistance(shapeEdges(s), v))
+}
+
+// shapeEdges are the world edges of every edge based shape, a region lists
+// its holes after its outer ring
+func shapeEdges(s Shape) []Edge {
+	switch shape := s.(type) {
+	case Rectangle:
+		return shape.Edges[:]
+	case Triangle:
+		return shape.Edges[:]
+	case Polygon:
+		return shape.Edges
+	case Region:
+		edges := append([]Edge{}, shape.Outer.Edges...)
+		for _, hole := range shape.Holes {
+			edges = append(edges, hole.Edges...)
+		}
+		return edges
+	}
+
+	return nil
+}
+
+func closestEdgePoint[T Float](edges []EdgeOf[T], v VectorOf[T]) VectorOf[T] {
+	closest := VectorOf[T]{}
+	distance := T(math.Inf(1))
+	for _, edge := range edges {
+		point := edge.ClosestPoint(v)
+		if d := v.Distance(point); d < distance {
+			closest, distance = point, d
+		}
+	}
+
+	return closest
+}
+
+func signedEdgeDistance[T Float](edges []EdgeOf[T], v VectorOf[T]) T {
+	distance := v.Distance(closestEdgePoint(edges, v))
+	if edgesContain(edges, v) {
+		return -distance
+	}
+
+	return distance
+}
+
+// edgesContain counts crossings, so holes listed with the outer ring work too
+func edgesContain[T Float](edges []EdgeOf[T], v VectorOf[T]) bool {
+	inside := false
+	for _, edge := range edges {
+		a, b := edge.Start, edge.End
+		if (a.Y > v.Y) != (b.Y > v.Y) &&
+			v.X < (b.X-a.X)*(v.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+func closestSegmentPoint[T Float](v, a, b VectorOf[T]) VectorOf[T] {
+	ab := b.Subtract(a)
+	length := ab.DotProduct(ab)
+	if length == 0 {
+		return a
+	}
+
+	t := max(0, min(1, v.Subtract(a).DotProduct(ab)/length))
+	return a.Add(ab.Scale(t))
+}
@@ -0,0 +1,212 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_edge_ClosestPoint(t *testing.T) {
+	edge := mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(4, 0)}
+	type input struct {
+		v mosaic.Vector
+	}
+	type want struct {
+		point    mosaic.Vector
+		distance float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "above the middle",
+			input: input{v: mosaic.NewVector(1, 2)},
+			want:  want{point: mosaic.NewVector(1, 0), distance: 2},
+		},
+		{
+			name:  "past the end",
+			input: input{v: mosaic.NewVector(7, 4)},
+			want:  want{point: mosaic.NewVector(4, 0), distance: 5},
+		},
+		{
+			name:  "before the start",
+			input: input{v: mosaic.NewVector(-1, 0)},
+			want:  want{point: mosaic.NewVector(0, 0), distance: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := edge.ClosestPoint(tt.input.v)
+			if got != tt.want.point {
+				t.Errorf("edge.ClosestPoint() got = %v, want %v", got, tt.want.point)
+			}
+
+			distance := edge.DistanceTo(tt.input.v)
+			if !WithinTolerance(distance, tt.want.distance, 0.0001) {
+				t.Errorf("edge.DistanceTo() got = %v, want %v", distance, tt.want.distance)
+			}
+		})
+	}
+}
+
+func Test_shape_SignedDistance(t *testing.T) {
+	type signedShape interface {
+		ClosestPoint(v mosaic.Vector) mosaic.Vector
+		DistanceTo(v mosaic.Vector) float64
+		SignedDistance(v mosaic.Vector) float64
+	}
+	type input struct {
+		shape signedShape
+		v     mosaic.Vector
+	}
+	type want struct {
+		point  mosaic.Vector
+		signed float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "outside circle",
+			input: input{shape: mosaic.NewCircle(mosaic.NewVector(0, 0), 1), v: mosaic.NewVector(3, 0)},
+			want:  want{point: mosaic.NewVector(1, 0), signed: 2},
+		},
+		{
+			name:  "inside circle",
+			input: input{shape: mosaic.NewCircle(mosaic.NewVector(0, 0), 2), v: mosaic.NewVector(0, 0.5)},
+			want:  want{point: mosaic.NewVector(0, 2), signed: -1.5},
+		},
+		{
+			name:  "outside rectangle corner",
+			input: input{shape: mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2), v: mosaic.NewVector(4, 5)},
+			want:  want{point: mosaic.NewVector(1, 1), signed: 5},
+		},
+		{
+			name:  "inside rectangle",
+			input: input{shape: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 2), v: mosaic.NewVector(1.5, 0)},
+			want:  want{point: mosaic.NewVector(2, 0), signed: -0.5},
+		},
+		{
+			name: "inside triangle",
+			input: input{
+				shape: mosaic.NewTriangle(
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(4, 0),
+					mosaic.NewVector(0, 4),
+				),
+				v: mosaic.NewVector(1, 0.5),
+			},
+			want: want{point: mosaic.NewVector(1, 0), signed: -0.5},
+		},
+		{
+			name:  "outside polygon",
+			input: input{shape: square(0, 0, 2), v: mosaic.NewVector(-3, 0.5)},
+			want:  want{point: mosaic.NewVector(-1, 0.5), signed: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.shape.SignedDistance(tt.input.v)
+			if !WithinTolerance(got, tt.want.signed, 0.0001) {
+				t.Errorf("shape.SignedDistance() got = %v, want %v", got, tt.want.signed)
+			}
+
+			distance := tt.input.shape.DistanceTo(tt.input.v)
+			if !WithinTolerance(distance, math.Max(0, tt.want.signed), 0.0001) {
+				t.Errorf("shape.DistanceTo() got = %v, want %v", distance, math.Max(0, tt.want.signed))
+			}
+
+			point := tt.input.shape.ClosestPoint(tt.input.v)
+			if !WithinTolerance(point.X, tt.want.point.X, 0.0001) || !WithinTolerance(point.Y, tt.want.point.Y, 0.0001) {
+				t.Errorf("shape.ClosestPoint() got = %v, want %v", point, tt.want.point)
+			}
+		})
+	}
+}
+
+func Test_Distance(t *testing.T) {
+	donut := mosaic.NewRegion(square(0, 0, 10), square(0, 0, 6))
+	type input struct {
+		a mosaic.Shape
+		b mosaic.Shape
+	}
+	type want struct {
+		distance float64
+		signed   float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "circles apart",
+			input: input{
+				a: mosaic.NewCircle(mosaic.NewVector(0, 0), 1),
+				b: mosaic.NewCircle(mosaic.NewVector(5, 0), 2),
+			},
+			want: want{distance: 2, signed: 2},
+		},
+		{
+			name: "circles overlapping",
+			input: input{
+				a: mosaic.NewCircle(mosaic.NewVector(0, 0), 1),
+				b: mosaic.NewCircle(mosaic.NewVector(1.5, 0), 1),
+			},
+			want: want{distance: 0, signed: -0.5},
+		},
+		{
+			name:  "circle and polygon",
+			input: input{a: mosaic.NewCircle(mosaic.NewVector(0, 4), 1), b: square(0, 0, 2)},
+			want:  want{distance: 2, signed: 2},
+		},
+		{
+			name:  "polygon and circle",
+			input: input{a: square(0, 0, 2), b: mosaic.NewCircle(mosaic.NewVector(4, 4), 1)},
+			want:  want{distance: 3*math.Sqrt2 - 1, signed: 3*math.Sqrt2 - 1},
+		},
+		{
+			name:  "rectangle and polygon",
+			input: input{a: mosaic.NewRectangle(mosaic.NewVector(5, 0), 2, 2), b: square(0, 0, 2)},
+			want:  want{distance: 3, signed: 3},
+		},
+		{
+			name:  "overlapping polygons",
+			input: input{a: square(0, 0, 2), b: square(1.5, 0, 2)},
+			want:  want{distance: 0, signed: -0.5},
+		},
+		{
+			name:  "polygon inside polygon",
+			input: input{a: square(0, 0, 10), b: square(1, 1, 2)},
+			want:  want{distance: 0},
+		},
+		{
+			name:  "polygon in the hole of a region",
+			input: input{a: donut, b: square(0, 0, 2)},
+			want:  want{distance: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.Distance(tt.input.a, tt.input.b)
+			if !WithinTolerance(got, tt.want.distance, 0.0001) {
+				t.Errorf("mosaic.Distance() got = %v, want %v", got, tt.want.distance)
+			}
+
+			if tt.want.signed == 0 {
+				return
+			}
+
+			signed := mosaic.SignedDistance(tt.input.a, tt.input.b)
+			if !WithinTolerance(signed, tt.want.signed, 0.0001) {
+				t.Errorf("mosaic.SignedDistance() got = %v, want %v", signed, tt.want.signed)
+			}
+		})
+	}
+}
@@ -1,22 +1,88 @@
 package mosaic
 
 type (
-	Edge struct {
-		Start  Vector
-		End    Vector
+	EdgeOf[T Float] struct {
+		Start  VectorOf[T]
+		End    VectorOf[T]
 		Active bool
 	}
+
+	Edge = EdgeOf[float64]
 )
 
-func (e Edge) Transform(t Transform) Edge {
-	return Edge{
+// ConvertEdge changes the precision of e
+func ConvertEdge[U, T Float](e EdgeOf[T]) EdgeOf[U] {
+	return EdgeOf[U]{
+		Start:  ConvertVector[U](e.Start),
+		End:    ConvertVector[U](e.End),
+		Active: e.Active,
+	}
+}
+
+// ConvertEdges changes the precision of every edge into a new slice, a slice
+// that is already in U is returned as is
+func ConvertEdges[U, T Float](edges []EdgeOf[T]) []EdgeOf[U] {
+	if same, ok := any(edges).([]EdgeOf[U]); ok {
+		return same
+	}
+
+	result := make([]EdgeOf[U], len(edges))
+	for i, e := range edges {
+		result[i] = ConvertEdge[U](e)
+	}
+
+	return result
+}
+
+func (e EdgeOf[T]) Transform(t Transform) EdgeOf[T] {
+	return EdgeOf[T]{
 		Start:  e.Start.Transform(t),
 		End:    e.End.Transform(t),
 		Active: e.Active,
 	}
 }
 
-func (e Edge) RayCount(v Vector) int {
+// CastRay hits either side of an active edge, the hit is worked out in
+// float64
+func (e EdgeOf[T]) CastRay(r Ray) RayHit {
+	return castRayEdge(ConvertEdge[float64](e), r)
+}
+
+func castRayEdge(e Edge, r Ray) RayHit {
+	if !e.Active || r.Distance <= 0 {
+		return RayHit{}
+	}
+
+	d := r.Direction.Scale(r.Distance)
+	s := e.End.Subtract(e.Start)
+
+	denominator := d.CrossProduct(s)
+	if denominator == 0 {
+		return RayHit{}
+	}
+
+	offset := e.Start.Subtract(r.Origin)
+	t := offset.CrossProduct(s) / denominator
+	u := offset.CrossProduct(d) / denominator
+
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return RayHit{}
+	}
+
+	normal := s.Perpendicular().Normalize()
+	if normal.DotProduct(d) > 0 {
+		normal = normal.Invert()
+	}
+
+	return RayHit{
+		Hit:      true,
+		Point:    r.At(t),
+		Normal:   normal,
+		Fraction: t,
+	}
+}
+
+func (e EdgeOf[T]) RayCount(v VectorOf[T]) int {
 	rayCount := 0
 	start := e.Start
 	end := e.End
@@ -41,7 +107,7 @@ func (e Edge) RayCount(v Vector) int {
 	return rayCount
 }
 
-func (e Edge) XIntersect(f Edge) float64 {
+func (e EdgeOf[T]) XIntersect(f EdgeOf[T]) T {
 	xNumerator := (e.Start.X*e.End.Y-e.Start.Y*e.End.X)*(f.Start.X-f.End.X) -
 		(e.Start.X-e.End.X)*(f.Start.X*f.End.Y-f.Start.Y*f.End.X)
 	denominator := (e.Start.X-e.End.X)*(f.Start.Y-f.End.Y) -
@@ -50,7 +116,7 @@ func (e Edge) XIntersect(f Edge) float64 {
 	return xNumerator / denominator
 }
 
-func (e Edge) YIntersect(f Edge) float64 {
+func (e EdgeOf[T]) YIntersect(f EdgeOf[T]) T {
 	yNumerator := (e.Start.X*e.End.Y-e.Start.Y*e.End.X)*(f.Start.Y-f.End.Y) -
 		(e.Start.Y-e.End.Y)*(f.Start.X*f.End.Y-f.Start.Y*f.End.X)
 	denominator := (e.Start.X-e.End.X)*(f.Start.Y-f.End.Y) -
@@ -59,7 +125,9 @@ func (e Edge) YIntersect(f Edge) float64 {
 	return yNumerator / denominator
 }
 
-func (e Edge) Intersect(f Edge) Vector {
+// Intersect treats e and f as infinite lines, parallel edges give NaN or Inf,
+// IntersectSegment handles them
+func (e EdgeOf[T]) Intersect(f EdgeOf[T]) VectorOf[T] {
 	xNumerator := (e.Start.X*e.End.Y-e.Start.Y*e.End.X)*(f.Start.X-f.End.X) -
 		(e.Start.X-e.End.X)*(f.Start.X*f.End.Y-f.Start.Y*f.End.X)
 	yNumerator := (e.Start.X*e.End.Y-e.Start.Y*e.End.X)*(f.Start.Y-f.End.Y) -
@@ -67,11 +135,22 @@ func (e Edge) Intersect(f Edge) Vector {
 	denominator := (e.Start.X-e.End.X)*(f.Start.Y-f.End.Y) -
 		(e.Start.Y-e.End.Y)*(f.Start.X-f.End.X)
 
-	return Vector{X: xNumerator / denominator, Y: yNumerator / denominator}
+	return VectorOf[T]{X: xNumerator / denominator, Y: yNumerator / denominator}
 }
 
 // Assuming CCW orientation
-func (e Edge) ContainsVector(v Vector) bool {
+func (e EdgeOf[T]) ContainsVector(v VectorOf[T]) bool {
 	return (e.End.X-e.Start.X)*(v.Y-e.Start.Y) >
 		(e.End.Y-e.Start.Y)*(v.X-e.Start.X)
 }
+
+// scaleEdges scales edges about the origin into a new slice
+func scaleEdges[T Float](edges []EdgeOf[T], c T) []EdgeOf[T] {
+	scale := NewTransform(0, 0, float64(c), 0)
+	result := make([]EdgeOf[T], len(edges))
+	for i, edge := range edges {
+		result[i] = edge.Transform(scale)
+	}
+
+	return result
+}
@@ -0,0 +1,312 @@
+package mosaic
+
+import "math"
+
+const (
+	gjkIterations = 64
+	gjkTolerance  = 1e-12
+	epaTolerance  = 1e-12
+)
+
+type (
+	// Convex shapes expose the furthest point along a direction so they can
+	// be used with GJK and EPA
+	Convex interface {
+		Support(direction Vector) Vector
+	}
+
+	Proximity struct {
+		Overlap bool
+		// Distance between ClosestA and ClosestB when the shapes are apart
+		Distance float64
+		ClosestA Vector
+		ClosestB Vector
+		// Normal points from a to b, Depth is only set when overlapping
+		Normal Vector
+		Depth  float64
+	}
+
+	simplexVertex struct {
+		a Vector
+		b Vector
+		// w is a - b, a point of the Minkowski difference
+		w      Vector
+		weight float64
+	}
+
+	simplex struct {
+		vertices [3]simplexVertex
+		count    int
+	}
+)
+
+// GJK finds the separation between two convex shapes, falling back to EPA
+// for the penetration normal and depth when they overlap
+func GJK(a, b Convex) Proximity {
+	s := simplex{count: 1}
+	s.vertices[0] = newSimplexVertex(a, b, Vector{X: 1, Y: 0})
+	s.vertices[0].weight = 1
+
+	for i := 0; i < gjkIterations; i++ {
+		switch s.count {
+		case 2:
+			s.solve2()
+		case 3:
+			s.solve3()
+		}
+
+		if s.count == 3 {
+			return epa(a, b, s)
+		}
+
+		v := s.closest()
+		if v.Length() < gjkTolerance {
+			return epa(a, b, s)
+		}
+
+		w := newSimplexVertex(a, b, v.Invert())
+		if v.Length()-v.DotProduct(w.w) <= gjkTolerance*v.Length() {
+			break
+		}
+
+		if s.contains(w.w) {
+			break
+		}
+
+		s.vertices[s.count] = w
+		s.count++
+	}
+
+	closestA, closestB := s.witnesses()
+	return Proximity{
+		Distance: closestA.Distance(closestB),
+		ClosestA: closestA,
+		ClosestB: closestB,
+		Normal:   closestB.Subtract(closestA).Normalize(),
+	}
+}
+
+func newSimplexVertex(a, b Convex, direction Vector) simplexVertex {
+	sa := a.Support(direction)
+	sb := b.Support(direction.Invert())
+
+	return simplexVertex{a: sa, b: sb, w: sa.Subtract(sb)}
+}
+
+func (s simplex) contains(w Vector) bool {
+	for i := 0; i < s.count; i++ {
+		if s.vertices[i].w == w {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s simplex) closest() Vector {
+	v := Vector{}
+	for i := 0; i < s.count; i++ {
+		v = v.Add(s.vertices[i].w.Scale(s.vertices[i].weight))
+	}
+
+	return v
+}
+
+func (s simplex) witnesses() (a, b Vector) {
+	for i := 0; i < s.count; i++ {
+		a = a.Add(s.vertices[i].a.Scale(s.vertices[i].weight))
+		b = b.Add(s.vertices[i].b.Scale(s.vertices[i].weight))
+	}
+
+	return a, b
+}
+
+// solve2 reduces a segment to the feature closest to the origin
+func (s *simplex) solve2() {
+	w1 := s.vertices[0].w
+	w2 := s.vertices[1].w
+	e12 := w2.Subtract(w1)
+
+	d12_2 := -w1.DotProduct(e12)
+	if d12_2 <= 0 {
+		s.vertices[0].weight = 1
+		s.count = 1
+		return
+	}
+
+	d12_1 := w2.DotProduct(e12)
+	if d12_1 <= 0 {
+		s.vertices[0] = s.vertices[1]
+		s.vertices[0].weight = 1
+		s.count = 1
+		return
+	}
+
+	inverse := 1 / (d12_1 + d12_2)
+	s.vertices[0].weight = d12_1 * inverse
+	s.vertices[1].weight = d12_2 * inverse
+	s.count = 2
+}
+
+// solve3 reduces a triangle to the feature closest to the origin
+func (s *simplex) solve3() {
+	w1 := s.vertices[0].w
+	w2 := s.vertices[1].w
+	w3 := s.vertices[2].w
+
+	e12 := w2.Subtract(w1)
+	d12_1 := w2.DotProduct(e12)
+	d12_2 := -w1.DotProduct(e12)
+
+	e13 := w3.Subtract(w1)
+	d13_1 := w3.DotProduct(e13)
+	d13_2 := -w1.DotProduct(e13)
+
+	e23 := w3.Subtract(w2)
+	d23_1 := w3.DotProduct(e23)
+	d23_2 := -w2.DotProduct(e23)
+
+	n123 := e12.CrossProduct(e13)
+	d123_1 := n123 * w2.CrossProduct(w3)
+	d123_2 := n123 * w3.CrossProduct(w1)
+	d123_3 := n123 * w1.CrossProduct(w2)
+
+	switch {
+	case d12_2 <= 0 && d13_2 <= 0:
+		s.vertices[0].weight = 1
+		s.count = 1
+	case d12_1 > 0 && d12_2 > 0 && d123_3 <= 0:
+		inverse := 1 / (d12_1 + d12_2)
+		s.vertices[0].weight = d12_1 * inverse
+		s.vertices[1].weight = d12_2 * inverse
+		s.count = 2
+	case d13_1 > 0 && d13_2 > 0 && d123_2 <= 0:
+		inverse := 1 / (d13_1 + d13_2)
+		s.vertices[0].weight = d13_1 * inverse
+		s.vertices[2].weight = d13_2 * inverse
+		s.vertices[1] = s.vertices[2]
+		s.count = 2
+	case d12_1 <= 0 && d23_2 <= 0:
+		s.vertices[0] = s.vertices[1]
+		s.vertices[0].weight = 1
+		s.count = 1
+	case d13_1 <= 0 && d23_1 <= 0:
+		s.vertices[0] = s.vertices[2]
+		s.vertices[0].weight = 1
+		s.count = 1
+	case d23_1 > 0 && d23_2 > 0 && d123_1 <= 0:
+		inverse := 1 / (d23_1 + d23_2)
+		s.vertices[1].weight = d23_1 * inverse
+		s.vertices[2].weight = d23_2 * inverse
+		s.vertices[0] = s.vertices[2]
+		s.count = 2
+	default:
+		inverse := 1 / (d123_1 + d123_2 + d123_3)
+		s.vertices[0].weight = d123_1 * inverse
+		s.vertices[1].weight = d123_2 * inverse
+		s.vertices[2].weight = d123_3 * inverse
+		s.count = 3
+	}
+}
+
+// epa expands the simplex enclosing the origin until it reaches the edge of
+// the Minkowski difference closest to the origin
+func epa(a, b Convex, s simplex) Proximity {
+	polytope := s.enclose(a, b)
+	if len(polytope) < 3 {
+		closestA, closestB := s.witnesses()
+		return Proximity{
+			Overlap:  true,
+			ClosestA: closestA,
+			ClosestB: closestB,
+		}
+	}
+
+	// Keep the polytope in CCW order so edge normals point outwards
+	area := 0.0
+	for i := 0; i < len(polytope); i++ {
+		area += polytope[i].w.CrossProduct(polytope[(i+1)%len(polytope)].w)
+	}
+	if area < 0 {
+		polytope[0], polytope[2] = polytope[2], polytope[0]
+	}
+
+	index, normal, distance := 0, Vector{}, 0.0
+	for i := 0; i < gjkIterations; i++ {
+		index, normal, distance = closestPolytopeEdge(polytope)
+
+		w := newSimplexVertex(a, b, normal)
+		if w.w.DotProduct(normal)-distance < epaTolerance {
+			break
+		}
+
+		polytope = append(polytope, simplexVertex{})
+		copy(polytope[index+2:], polytope[index+1:])
+		polytope[index+1] = w
+	}
+
+	start := polytope[index]
+	end := polytope[(index+1)%len(polytope)]
+	e := end.w.Subtract(start.w)
+	t := 0.0
+	if e.Length() > 0 {
+		t = math.Max(0, math.Min(1, -start.w.DotProduct(e)/e.Length()))
+	}
+
+	return Proximity{
+		Overlap:  true,
+		ClosestA: start.a.Add(end.a.Subtract(start.a).Scale(t)),
+		ClosestB: start.b.Add(end.b.Subtract(start.b).Scale(t)),
+		Normal:   normal,
+		Depth:    distance,
+	}
+}
+
+// enclose grows a degenerate simplex into a triangle so EPA has an area to
+// expand, touching shapes may not produce one
+func (s simplex) enclose(a, b Convex) []simplexVertex {
+	polytope := make([]simplexVertex, s.count, 3)
+	copy(polytope, s.vertices[:s.count])
+
+	if len(polytope) == 1 {
+		for _, direction := range []Vector{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}} {
+			w := newSimplexVertex(a, b, direction)
+			if w.w != polytope[0].w {
+				polytope = append(polytope, w)
+				break
+			}
+		}
+	}
+
+	if len(polytope) == 2 {
+		e := polytope[1].w.Subtract(polytope[0].w)
+		for _, direction := range []Vector{e.Perpendicular(), e.Perpendicular().Invert()} {
+			w := newSimplexVertex(a, b, direction)
+			if math.Abs(e.CrossProduct(w.w.Subtract(polytope[0].w))) > gjkTolerance {
+				polytope = append(polytope, w)
+				break
+			}
+		}
+	}
+
+	return polytope
+}
+
+func closestPolytopeEdge(polytope []simplexVertex) (index int, normal Vector, distance float64) {
+	distance = math.MaxFloat64
+
+	for i := 0; i < len(polytope); i++ {
+		start := polytope[i].w
+		end := polytope[(i+1)%len(polytope)].w
+		n := start.RightNormal(end)
+
+		d := n.DotProduct(start)
+		if d < distance {
+			index = i
+			normal = n
+			distance = d
+		}
+	}
+
+	return index, normal, distance
+}
@@ -0,0 +1,146 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_GJK(t *testing.T) {
+	type input struct {
+		a mosaic.Convex
+		b mosaic.Convex
+	}
+	type want struct {
+		overlap  bool
+		distance float64
+		closestA mosaic.Vector
+		closestB mosaic.Vector
+		normal   mosaic.Vector
+		depth    float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "rectangles apart",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+				b: mosaic.NewRectangle(mosaic.NewVector(10, 0), 4, 4),
+			},
+			want: want{
+				distance: 6,
+				closestA: mosaic.NewVector(2, 0),
+				closestB: mosaic.NewVector(8, 0),
+				normal:   mosaic.NewVector(1, 0),
+			},
+		},
+		{
+			name: "rectangles diagonal",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2),
+				b: mosaic.NewRectangle(mosaic.NewVector(5, 6), 2, 2),
+			},
+			want: want{
+				distance: 5,
+				closestA: mosaic.NewVector(1, 1),
+				closestB: mosaic.NewVector(4, 5),
+				normal:   mosaic.NewVector(0.6, 0.8),
+			},
+		},
+		{
+			name: "circle and triangle apart",
+			input: input{
+				a: mosaic.NewCircle(mosaic.NewVector(0, 5), 1),
+				b: mosaic.NewTriangle(
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(-2, 0),
+					mosaic.NewVector(2, 0),
+					mosaic.NewVector(0, 2),
+				),
+			},
+			want: want{
+				distance: 2,
+				closestA: mosaic.NewVector(0, 4),
+				closestB: mosaic.NewVector(0, 2),
+				normal:   mosaic.NewVector(0, -1),
+			},
+		},
+		{
+			name: "rectangles overlap",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+				b: mosaic.NewRectangle(mosaic.NewVector(3, 0.5), 4, 4),
+			},
+			want: want{
+				overlap: true,
+				normal:  mosaic.NewVector(1, 0),
+				depth:   1,
+			},
+		},
+		{
+			name: "circles overlap",
+			input: input{
+				a: mosaic.NewCircle(mosaic.NewVector(0, 0), 2),
+				b: mosaic.NewCircle(mosaic.NewVector(0, 3), 2),
+			},
+			want: want{
+				overlap: true,
+				normal:  mosaic.NewVector(0, 1),
+				depth:   1,
+			},
+		},
+		{
+			name: "polygon and circle overlap",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4).ToPolygon(),
+				b: mosaic.NewCircle(mosaic.NewVector(0, -3), 2),
+			},
+			want: want{
+				overlap: true,
+				normal:  mosaic.NewVector(0, -1),
+				depth:   1,
+			},
+		},
+	}
+	tolerance := 1e-6
+	near := func(v, w mosaic.Vector) bool {
+		return math.Abs(v.X-w.X) < tolerance && math.Abs(v.Y-w.Y) < tolerance
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.GJK(tt.input.a, tt.input.b)
+
+			if got.Overlap != tt.want.overlap {
+				t.Fatalf("mosaic.GJK() overlap = %v, want %v", got.Overlap, tt.want.overlap)
+			}
+
+			if !near(got.Normal, tt.want.normal) {
+				t.Errorf("mosaic.GJK() normal = %v, want %v", got.Normal, tt.want.normal)
+			}
+
+			if math.Abs(got.Depth-tt.want.depth) > tolerance {
+				t.Errorf("mosaic.GJK() depth = %v, want %v", got.Depth, tt.want.depth)
+			}
+
+			if tt.want.overlap {
+				return
+			}
+
+			if math.Abs(got.Distance-tt.want.distance) > tolerance {
+				t.Errorf("mosaic.GJK() distance = %v, want %v", got.Distance, tt.want.distance)
+			}
+
+			if !near(got.ClosestA, tt.want.closestA) {
+				t.Errorf("mosaic.GJK() closestA = %v, want %v", got.ClosestA, tt.want.closestA)
+			}
+
+			if !near(got.ClosestB, tt.want.closestB) {
+				t.Errorf("mosaic.GJK() closestB = %v, want %v", got.ClosestB, tt.want.closestB)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package mosaic
+
+import "sort"
+
+type (
+	HullOptions struct {
+		// KeepCollinear keeps points that fall on the edges of the hull
+		KeepCollinear bool
+		// Centered positions the hull at its centroid instead of the origin
+		Centered bool
+	}
+)
+
+// NewConvexHull builds the CCW convex hull of a set of points with Andrew's
+// monotone chain
+func NewConvexHull[T Float](vectors []VectorOf[T], options HullOptions) PolygonOf[T] {
+	hull := ConvertVectors[T](convexHull(ConvertVectors[float64](vectors), options.KeepCollinear))
+
+	position := VectorOf[T]{}
+	if options.Centered {
+		position = ringCentroid(hull)
+	}
+
+	return NewPolygon(position, relativeTo(hull, position))
+}
+
+func convexHull(vectors []Vector, keepCollinear bool) []Vector {
+	points := make([]Vector, len(vectors))
+	copy(points, vectors)
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].X == points[j].X {
+			return points[i].Y < points[j].Y
+		}
+		return points[i].X < points[j].X
+	})
+
+	unique := points[:0]
+	for i, v := range points {
+		if i == 0 || v != unique[len(unique)-1] {
+			unique = append(unique, v)
+		}
+	}
+	points = unique
+
+	if len(points) < 3 {
+		return points
+	}
+
+	// Points on an edge turn by zero, only drop them when asked to
+	pop := func(hull []Vector, v Vector) bool {
+		t := turn(hull[len(hull)-2], hull[len(hull)-1], v)
+		if keepCollinear {
+			return t < 0
+		}
+		return t <= 0
+	}
+
+	lower := make([]Vector, 0, len(points))
+	for _, v := range points {
+		for len(lower) >= 2 && pop(lower, v) {
+			lower = lower[:len(lower)-1]
+		}
+		lower = append(lower, v)
+	}
+
+	upper := make([]Vector, 0, len(points))
+	for i := len(points) - 1; i >= 0; i-- {
+		v := points[i]
+		for len(upper) >= 2 && pop(upper, v) {
+			upper = upper[:len(upper)-1]
+		}
+		upper = append(upper, v)
+	}
+
+	// Every point is on one line, both chains walk the same points
+	if len(lower) == len(points) && len(upper) == len(points) && signedArea(lower) == 0 {
+		if keepCollinear {
+			return lower
+		}
+		return []Vector{points[0], points[len(points)-1]}
+	}
+
+	return append(lower[:len(lower)-1], upper[:len(upper)-1]...)
+}
@@ -0,0 +1,127 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_NewConvexHull(t *testing.T) {
+	grid := []mosaic.Vector{}
+	for x := 0.0; x <= 2; x++ {
+		for y := 0.0; y <= 2; y++ {
+			grid = append(grid, mosaic.NewVector(x, y))
+		}
+	}
+
+	type input struct {
+		vectors []mosaic.Vector
+		options mosaic.HullOptions
+	}
+	type want struct {
+		position mosaic.Vector
+		vertices []mosaic.Vector
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "grid",
+			input: input{
+				vectors: grid,
+			},
+			want: want{
+				position: mosaic.NewVector(0, 0),
+				vertices: []mosaic.Vector{
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(2, 0),
+					mosaic.NewVector(2, 2),
+					mosaic.NewVector(0, 2),
+				},
+			},
+		},
+		{
+			name: "grid keeping collinear points",
+			input: input{
+				vectors: grid,
+				options: mosaic.HullOptions{KeepCollinear: true},
+			},
+			want: want{
+				position: mosaic.NewVector(0, 0),
+				vertices: []mosaic.Vector{
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(1, 0),
+					mosaic.NewVector(2, 0),
+					mosaic.NewVector(2, 1),
+					mosaic.NewVector(2, 2),
+					mosaic.NewVector(1, 2),
+					mosaic.NewVector(0, 2),
+					mosaic.NewVector(0, 1),
+				},
+			},
+		},
+		{
+			name: "centered cloud with duplicates",
+			input: input{
+				vectors: []mosaic.Vector{
+					mosaic.NewVector(4, 4),
+					mosaic.NewVector(2, 2),
+					mosaic.NewVector(6, 2),
+					mosaic.NewVector(3, 3),
+					mosaic.NewVector(6, 2),
+					mosaic.NewVector(6, 6),
+					mosaic.NewVector(2, 6),
+					mosaic.NewVector(5, 3),
+				},
+				options: mosaic.HullOptions{Centered: true},
+			},
+			want: want{
+				position: mosaic.NewVector(4, 4),
+				vertices: []mosaic.Vector{
+					mosaic.NewVector(-2, -2),
+					mosaic.NewVector(2, -2),
+					mosaic.NewVector(2, 2),
+					mosaic.NewVector(-2, 2),
+				},
+			},
+		},
+		{
+			name: "collinear points",
+			input: input{
+				vectors: []mosaic.Vector{
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(2, 2),
+					mosaic.NewVector(1, 1),
+				},
+			},
+			want: want{
+				position: mosaic.NewVector(0, 0),
+				vertices: []mosaic.Vector{
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(2, 2),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.NewConvexHull(tt.input.vectors, tt.input.options)
+
+			if got.Position != tt.want.position {
+				t.Errorf("mosaic.NewConvexHull() position = %v, want %v", got.Position, tt.want.position)
+			}
+
+			if len(got.Edges) != len(tt.want.vertices) {
+				t.Fatalf("mosaic.NewConvexHull() vertices = %v, want %v", got.Edges, tt.want.vertices)
+			}
+
+			for i, edge := range got.Edges {
+				if edge.Start.Subtract(got.Position) != tt.want.vertices[i] {
+					t.Errorf("mosaic.NewConvexHull() vertex %d = %v, want %v", i, edge.Start.Subtract(got.Position), tt.want.vertices[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package mosaic
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// sweepTolerance is how close, relative to the coordinates involved, an edge
+// must pass to an event point to count as going through it
+const sweepTolerance = 1e-9
+
+type (
+	IntersectionKind int
+
+	// SegmentIntersection describes where two edges meet. T holds the
+	// parameters of Start and End along the first edge and U along the
+	// second, for a single point Start and End are the same.
+	SegmentIntersection struct {
+		Kind  IntersectionKind
+		Start Vector
+		End   Vector
+		T     [2]float64
+		U     [2]float64
+	}
+
+	// EdgeIntersection is a point shared by two or more edges, Edges indexes
+	// the slice passed to Intersections
+	EdgeIntersection struct {
+		Point Vector
+		Edges []int
+	}
+)
+
+const (
+	NoIntersection IntersectionKind = iota
+	PointIntersection
+	OverlapIntersection
+)
+
+// IntersectSegment intersects e and f as segments in float64. Parallel edges
+// never intersect and collinear edges report the piece they share.
+func (e EdgeOf[T]) IntersectSegment(f EdgeOf[T]) SegmentIntersection {
+	return intersectSegments(ConvertEdge[float64](e), ConvertEdge[float64](f))
+}
+
+func intersectSegments(e, f Edge) SegmentIntersection {
+	r := e.End.Subtract(e.Start)
+	s := f.End.Subtract(f.Start)
+	offset := f.Start.Subtract(e.Start)
+	denominator := r.CrossProduct(s)
+
+	if denominator != 0 {
+		t := offset.CrossProduct(s) / denominator
+		u := offset.CrossProduct(r) / denominator
+		if t < 0 || t > 1 || u < 0 || u > 1 {
+			return SegmentIntersection{}
+		}
+
+		point := e.Start.Add(r.Scale(t))
+		return SegmentIntersection{
+			Kind:  PointIntersection,
+			Start: point,
+			End:   point,
+			T:     [2]float64{t, t},
+			U:     [2]float64{u, u},
+		}
+	}
+
+	if offset.CrossProduct(r) != 0 || offset.CrossProduct(s) != 0 {
+		return SegmentIntersection{}
+	}
+
+	rr := r.DotProduct(r)
+	ss := s.DotProduct(s)
+	switch {
+	case rr == 0 && ss == 0:
+		if e.Start != f.Start {
+			return SegmentIntersection{}
+		}
+		return SegmentIntersection{Kind: PointIntersection, Start: e.Start, End: e.Start}
+	case rr == 0:
+		// e is a point lying on the line through f
+		u := e.Start.Subtract(f.Start).DotProduct(s) / ss
+		if u < 0 || u > 1 {
+			return SegmentIntersection{}
+		}
+		return SegmentIntersection{
+			Kind:  PointIntersection,
+			Start: e.Start,
+			End:   e.Start,
+			U:     [2]float64{u, u},
+		}
+	}
+
+	// Collinear, clip f to the span of e
+	t0 := offset.DotProduct(r) / rr
+	t1 := t0 + s.DotProduct(r)/rr
+	if t0 > t1 {
+		t0, t1 = t1, t0
+	}
+	t0 = math.Max(t0, 0)
+	t1 = math.Min(t1, 1)
+	if t0 > t1 {
+		return SegmentIntersection{}
+	}
+
+	start := e.Start.Add(r.Scale(t0))
+	end := e.Start.Add(r.Scale(t1))
+	u := func(v Vector) float64 {
+		if ss == 0 {
+			return 0
+		}
+		return v.Subtract(f.Start).DotProduct(s) / ss
+	}
+
+	kind := OverlapIntersection
+	if t0 == t1 {
+		kind = PointIntersection
+	}
+
+	return SegmentIntersection{
+		Kind:  kind,
+		Start: start,
+		End:   end,
+		T:     [2]float64{t0, t1},
+		U:     [2]float64{u(start), u(end)},
+	}
+}
+
+// Intersections finds every point where two or more edges meet with a
+// Bentley-Ottmann sweep in O((n+k) log n). Touching endpoints count, and
+// collinear edges are reported at the ends of the piece they share.
+// Zero length edges are ignored.
+func Intersections(edges []Edge) []EdgeIntersection {
+	s := sweep{
+		edges:  make([]Edge, len(edges)),
+		events: eventQueue{},
+		starts: make(map[Vector][]int),
+		queued: make(map[Vector]bool),
+	}
+
+	for i, edge := range edges {
+		if sweepBefore(edge.End, edge.Start) {
+			edge.Start, edge.End = edge.End, edge.Start
+		}
+		s.edges[i] = edge
+
+		if edge.Start == edge.End {
+			continue
+		}
+
+		s.starts[edge.Start] = append(s.starts[edge.Start], i)
+		s.push(edge.Start)
+		s.push(edge.End)
+	}
+
+	intersections := []EdgeIntersection{}
+	for s.events.Len() > 0 {
+		p := heap.Pop(&s.events).(Vector)
+		if found := s.handle(p); len(found) > 1 {
+			sort.Ints(found)
+			intersections = append(intersections, EdgeIntersection{Point: p, Edges: found})
+		}
+	}
+
+	return intersections
+}
+
+type (
+	sweep struct {
+		edges  []Edge
+		events eventQueue
+		starts map[Vector][]int
+		queued map[Vector]bool
+		status *sweepNode
+	}
+
+	// sweepNode is a treap of edges ordered by y where they cross the
+	// sweep line
+	sweepNode struct {
+		edge     int
+		priority uint32
+		left     *sweepNode
+		right    *sweepNode
+	}
+
+	eventQueue []Vector
+)
+
+// handle processes the event at p and returns every edge through it
+func (s *sweep) handle(p Vector) []int {
+	tolerance := sweepTolerance * math.Max(1, math.Abs(p.Y))
+	below, rest := splitSweep(s.status, func(edge int) bool {
+		return s.key(edge, p) < p.Y-tolerance
+	})
+	through, above := splitSweep(rest, func(edge int) bool {
+		return s.key(edge, p) <= p.Y+tolerance
+	})
+
+	found := append([]int{}, s.starts[p]...)
+	continuing := append([]int{}, s.starts[p]...)
+	for _, edge := range collectSweep(through, nil) {
+		found = append(found, edge)
+		if s.edges[edge].End != p {
+			continuing = append(continuing, edge)
+		}
+	}
+
+	// Just past p the edges are ordered by slope
+	sort.SliceStable(continuing, func(i, j int) bool {
+		return s.slope(continuing[i]) < s.slope(continuing[j])
+	})
+
+	middle := (*sweepNode)(nil)
+	for _, edge := range continuing {
+		middle = mergeSweep(middle, &sweepNode{edge: edge, priority: rand.Uint32()})
+	}
+
+	lower, upper := maxSweep(below), minSweep(above)
+	if len(continuing) == 0 {
+		if lower != nil && upper != nil {
+			s.check(lower.edge, upper.edge, p)
+		}
+	} else {
+		if lower != nil {
+			s.check(lower.edge, continuing[0], p)
+		}
+		if upper != nil {
+			s.check(continuing[len(continuing)-1], upper.edge, p)
+		}
+	}
+
+	s.status = mergeSweep(mergeSweep(below, middle), above)
+	return found
+}
+
+// check queues the crossing of two neighbouring edges if the sweep has not
+// reached it yet
+func (s *sweep) check(a, b int, p Vector) {
+	intersection := s.edges[a].IntersectSegment(s.edges[b])
+	if intersection.Kind != PointIntersection {
+		return
+	}
+
+	if sweepBefore(p, intersection.Start) {
+		s.push(intersection.Start)
+	}
+}
+
+func (s *sweep) push(v Vector) {
+	if s.queued[v] {
+		return
+	}
+
+	s.queued[v] = true
+	heap.Push(&s.events, v)
+}
+
+// key is where the edge crosses the sweep line through p, vertical edges sit
+// at p while the sweep is on them
+func (s *sweep) key(edge int, p Vector) float64 {
+	e := s.edges[edge]
+	if e.Start.X == e.End.X {
+		return math.Max(e.Start.Y, math.Min(e.End.Y, p.Y))
+	}
+
+	return e.Start.Y + (p.X-e.Start.X)*(e.End.Y-e.Start.Y)/(e.End.X-e.Start.X)
+}
+
+func (s *sweep) slope(edge int) float64 {
+	e := s.edges[edge]
+	if e.Start.X == e.End.X {
+		return math.Inf(1)
+	}
+
+	return (e.End.Y - e.Start.Y) / (e.End.X - e.Start.X)
+}
+
+// sweepBefore orders events left to right, then bottom to top
+func sweepBefore(a, b Vector) bool {
+	return a.X < b.X || (a.X == b.X && a.Y < b.Y)
+}
+
+// splitSweep separates the leading edges for which below holds
+func splitSweep(n *sweepNode, below func(edge int) bool) (*sweepNode, *sweepNode) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if below(n.edge) {
+		left, right := splitSweep(n.right, below)
+		n.right = left
+		return n, right
+	}
+
+	left, right := splitSweep(n.left, below)
+	n.left = right
+	return left, n
+}
+
+func mergeSweep(a, b *sweepNode) *sweepNode {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if a.priority > b.priority {
+		a.right = mergeSweep(a.right, b)
+		return a
+	}
+
+	b.left = mergeSweep(a, b.left)
+	return b
+}
+
+func collectSweep(n *sweepNode, edges []int) []int {
+	if n == nil {
+		return edges
+	}
+
+	edges = collectSweep(n.left, edges)
+	edges = append(edges, n.edge)
+	return collectSweep(n.right, edges)
+}
+
+func minSweep(n *sweepNode) *sweepNode {
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+func maxSweep(n *sweepNode) *sweepNode {
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
+func (q eventQueue) Len() int           { return len(q) }
+func (q eventQueue) Less(i, j int) bool { return sweepBefore(q[i], q[j]) }
+func (q eventQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x any) {
+	*q = append(*q, x.(Vector))
+}
+
+func (q *eventQueue) Pop() any {
+	old := *q
+	v := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return v
+}
@@ -0,0 +1,168 @@
+package mosaic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_edge_IntersectSegment(t *testing.T) {
+	type input struct {
+		e mosaic.Edge
+		f mosaic.Edge
+	}
+	type want struct {
+		result mosaic.SegmentIntersection
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "crossing",
+			input: input{
+				e: mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(4, 0)},
+				f: mosaic.Edge{Start: mosaic.NewVector(1, -1), End: mosaic.NewVector(1, 3)},
+			},
+			want: want{result: mosaic.SegmentIntersection{
+				Kind:  mosaic.PointIntersection,
+				Start: mosaic.NewVector(1, 0),
+				End:   mosaic.NewVector(1, 0),
+				T:     [2]float64{0.25, 0.25},
+				U:     [2]float64{0.25, 0.25},
+			}},
+		},
+		{
+			name: "lines cross beyond the segments",
+			input: input{
+				e: mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(4, 0)},
+				f: mosaic.Edge{Start: mosaic.NewVector(5, -1), End: mosaic.NewVector(5, 1)},
+			},
+			want: want{result: mosaic.SegmentIntersection{}},
+		},
+		{
+			name: "parallel",
+			input: input{
+				e: mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(4, 0)},
+				f: mosaic.Edge{Start: mosaic.NewVector(0, 1), End: mosaic.NewVector(4, 1)},
+			},
+			want: want{result: mosaic.SegmentIntersection{}},
+		},
+		{
+			name: "collinear apart",
+			input: input{
+				e: mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(1, 0)},
+				f: mosaic.Edge{Start: mosaic.NewVector(2, 0), End: mosaic.NewVector(4, 0)},
+			},
+			want: want{result: mosaic.SegmentIntersection{}},
+		},
+		{
+			name: "collinear touching",
+			input: input{
+				e: mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(2, 0)},
+				f: mosaic.Edge{Start: mosaic.NewVector(2, 0), End: mosaic.NewVector(4, 0)},
+			},
+			want: want{result: mosaic.SegmentIntersection{
+				Kind:  mosaic.PointIntersection,
+				Start: mosaic.NewVector(2, 0),
+				End:   mosaic.NewVector(2, 0),
+				T:     [2]float64{1, 1},
+				U:     [2]float64{0, 0},
+			}},
+		},
+		{
+			name: "overlapping in opposite directions",
+			input: input{
+				e: mosaic.Edge{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(4, 0)},
+				f: mosaic.Edge{Start: mosaic.NewVector(6, 0), End: mosaic.NewVector(2, 0)},
+			},
+			want: want{result: mosaic.SegmentIntersection{
+				Kind:  mosaic.OverlapIntersection,
+				Start: mosaic.NewVector(2, 0),
+				End:   mosaic.NewVector(4, 0),
+				T:     [2]float64{0.5, 1},
+				U:     [2]float64{1, 0.5},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.e.IntersectSegment(tt.input.f)
+			if got != tt.want.result {
+				t.Errorf("edge.IntersectSegment() got = %+v, want %+v", got, tt.want.result)
+			}
+		})
+	}
+}
+
+func Test_Intersections(t *testing.T) {
+	type input struct {
+		edges []mosaic.Edge
+	}
+	type want struct {
+		intersections []mosaic.EdgeIntersection
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "hash",
+			input: input{edges: []mosaic.Edge{
+				{Start: mosaic.NewVector(0, 1), End: mosaic.NewVector(3, 1)},
+				{Start: mosaic.NewVector(3, 2), End: mosaic.NewVector(0, 2)},
+				{Start: mosaic.NewVector(1, 0), End: mosaic.NewVector(1, 3)},
+				{Start: mosaic.NewVector(2, 3), End: mosaic.NewVector(2, 0)},
+			}},
+			want: want{intersections: []mosaic.EdgeIntersection{
+				{Point: mosaic.NewVector(1, 1), Edges: []int{0, 2}},
+				{Point: mosaic.NewVector(1, 2), Edges: []int{1, 2}},
+				{Point: mosaic.NewVector(2, 1), Edges: []int{0, 3}},
+				{Point: mosaic.NewVector(2, 2), Edges: []int{1, 3}},
+			}},
+		},
+		{
+			name: "three through one point",
+			input: input{edges: []mosaic.Edge{
+				{Start: mosaic.NewVector(-1, -1), End: mosaic.NewVector(1, 1)},
+				{Start: mosaic.NewVector(-1, 1), End: mosaic.NewVector(1, -1)},
+				{Start: mosaic.NewVector(0, -1), End: mosaic.NewVector(0, 1)},
+				{Start: mosaic.NewVector(5, 5), End: mosaic.NewVector(6, 6)},
+			}},
+			want: want{intersections: []mosaic.EdgeIntersection{
+				{Point: mosaic.NewVector(0, 0), Edges: []int{0, 1, 2}},
+			}},
+		},
+		{
+			name: "shared endpoint and overlap",
+			input: input{edges: []mosaic.Edge{
+				{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(2, 0)},
+				{Start: mosaic.NewVector(2, 0), End: mosaic.NewVector(2, 2)},
+				{Start: mosaic.NewVector(1, 0), End: mosaic.NewVector(3, 0)},
+			}},
+			want: want{intersections: []mosaic.EdgeIntersection{
+				{Point: mosaic.NewVector(1, 0), Edges: []int{0, 2}},
+				{Point: mosaic.NewVector(2, 0), Edges: []int{0, 1, 2}},
+			}},
+		},
+		{
+			name: "none",
+			input: input{edges: []mosaic.Edge{
+				{Start: mosaic.NewVector(0, 0), End: mosaic.NewVector(2, 0)},
+				{Start: mosaic.NewVector(0, 1), End: mosaic.NewVector(2, 1)},
+			}},
+			want: want{intersections: []mosaic.EdgeIntersection{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.Intersections(tt.input.edges)
+			if !reflect.DeepEqual(got, tt.want.intersections) {
+				t.Errorf("mosaic.Intersections() got = %v, want %v", got, tt.want.intersections)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package mosaic
+
+import "math"
+
+type (
+	Contact struct {
+		Point Vector
+		Depth float64
+	}
+
+	// Manifold describes the contact between two shapes, Normal points from
+	// the first shape to the second and only Contacts[:Count] are valid
+	Manifold struct {
+		Normal   Vector
+		Contacts [2]Contact
+		Count    int
+	}
+
+	clipFeature struct {
+		start Vector
+		end   Vector
+	}
+)
+
+// edgeManifold clips the incident face against the reference face, the edges
+// are expected to describe convex shapes that overlap along normal
+func edgeManifold(p, q []Edge, normal Vector, depth float64) Manifold {
+	if depth == 0.0 || len(p) < 2 || len(q) < 2 {
+		return Manifold{}
+	}
+
+	reference := bestFeature(p, normal)
+	incident := bestFeature(q, normal.Invert())
+	referenceNormal := normal
+
+	referenceEdge := reference.end.Subtract(reference.start).Normalize()
+	incidentEdge := incident.end.Subtract(incident.start).Normalize()
+	if math.Abs(referenceEdge.DotProduct(normal)) > math.Abs(incidentEdge.DotProduct(normal)) {
+		reference, incident = incident, reference
+		referenceEdge = incidentEdge
+		referenceNormal = normal.Invert()
+	}
+
+	points := clipPoints(
+		[]Vector{incident.start, incident.end},
+		referenceEdge,
+		referenceEdge.DotProduct(reference.start),
+	)
+	points = clipPoints(
+		points,
+		referenceEdge.Invert(),
+		-referenceEdge.DotProduct(reference.end),
+	)
+
+	faceNormal := referenceEdge.Perpendicular()
+	if faceNormal.DotProduct(referenceNormal) < 0 {
+		faceNormal = faceNormal.Invert()
+	}
+	faceDistance := faceNormal.DotProduct(reference.start)
+
+	m := Manifold{Normal: normal}
+	for _, point := range points {
+		d := faceDistance - faceNormal.DotProduct(point)
+		if d < 0 || m.Count == len(m.Contacts) {
+			continue
+		}
+
+		m.Contacts[m.Count] = Contact{Point: point, Depth: d}
+		m.Count++
+	}
+
+	return m
+}
+
+// bestFeature finds the edge most perpendicular to normal touching the vertex
+// furthest along it
+func bestFeature(edges []Edge, normal Vector) clipFeature {
+	index := 0
+	best := -math.MaxFloat64
+	for i, edge := range edges {
+		projection := edge.Start.DotProduct(normal)
+		if projection > best {
+			best = projection
+			index = i
+		}
+	}
+
+	v := edges[index].Start
+	next := edges[index].End
+	prev := edges[(index-1+len(edges))%len(edges)].Start
+
+	left := v.Subtract(prev).Normalize()
+	right := v.Subtract(next).Normalize()
+
+	if right.DotProduct(normal) <= left.DotProduct(normal) {
+		return clipFeature{start: v, end: next}
+	}
+
+	return clipFeature{start: prev, end: v}
+}
+
+// clipPoints keeps the part of the segment where axis·point >= offset
+func clipPoints(points []Vector, axis Vector, offset float64) []Vector {
+	if len(points) < 2 {
+		return points
+	}
+
+	clipped := make([]Vector, 0, 2)
+	d1 := axis.DotProduct(points[0]) - offset
+	d2 := axis.DotProduct(points[1]) - offset
+
+	if d1 >= 0 {
+		clipped = append(clipped, points[0])
+	}
+	if d2 >= 0 {
+		clipped = append(clipped, points[1])
+	}
+
+	if d1*d2 < 0 {
+		t := d1 / (d1 - d2)
+		clipped = append(clipped, points[0].Add(points[1].Subtract(points[0]).Scale(t)))
+	}
+
+	return clipped
+}
@@ -0,0 +1,96 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_Manifold(t *testing.T) {
+	type setup struct {
+		polygon mosaic.Polygon
+	}
+	type input struct {
+		polygon mosaic.Polygon
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		input input
+		want  mosaic.Manifold
+	}{
+		{
+			name: "stacked",
+			setup: setup{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4).ToPolygon(),
+			},
+			input: input{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(1, 3.5), 4, 4).ToPolygon(),
+			},
+			want: mosaic.Manifold{
+				Normal: mosaic.NewVector(0, 1),
+				Contacts: [2]mosaic.Contact{
+					{Point: mosaic.NewVector(-1, 1.5), Depth: 0.5},
+					{Point: mosaic.NewVector(2, 1.5), Depth: 0.5},
+				},
+				Count: 2,
+			},
+		},
+		{
+			name: "corner",
+			setup: setup{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4).ToPolygon(),
+			},
+			input: input{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2).
+					Transform(mosaic.NewTransform(0.5, 3, 1, 45)).
+					ToPolygon(),
+			},
+			want: mosaic.Manifold{
+				Normal: mosaic.NewVector(0, 1),
+				Contacts: [2]mosaic.Contact{
+					{Point: mosaic.NewVector(0.5, 3-math.Sqrt2), Depth: math.Sqrt2 - 1},
+				},
+				Count: 1,
+			},
+		},
+		{
+			name: "apart",
+			setup: setup{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4).ToPolygon(),
+			},
+			input: input{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(10, 0), 4, 4).ToPolygon(),
+			},
+			want: mosaic.Manifold{},
+		},
+	}
+	tolerance := 1e-9
+	near := func(v, w mosaic.Vector) bool {
+		return math.Abs(v.X-w.X) < tolerance && math.Abs(v.Y-w.Y) < tolerance
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup.polygon.Manifold(tt.input.polygon)
+
+			if got.Count != tt.want.Count {
+				t.Fatalf("polygon.Manifold() count = %v, want %v", got.Count, tt.want.Count)
+			}
+
+			if !near(got.Normal, tt.want.Normal) {
+				t.Errorf("polygon.Manifold() normal = %v, want %v", got.Normal, tt.want.Normal)
+			}
+
+			for i := 0; i < got.Count; i++ {
+				if !near(got.Contacts[i].Point, tt.want.Contacts[i].Point) {
+					t.Errorf("polygon.Manifold() contact %d point = %v, want %v", i, got.Contacts[i].Point, tt.want.Contacts[i].Point)
+				}
+
+				if math.Abs(got.Contacts[i].Depth-tt.want.Contacts[i].Depth) > tolerance {
+					t.Errorf("polygon.Manifold() contact %d depth = %v, want %v", i, got.Contacts[i].Depth, tt.want.Contacts[i].Depth)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package mosaic
+
+import "math"
+
+func (c CircleOf[T]) Centroid() VectorOf[T] {
+	return c.Position
+}
+
+func (c CircleOf[T]) SignedArea() T {
+	return c.Area()
+}
+
+func (c CircleOf[T]) Area() T {
+	return T(math.Pi) * c.Radius * c.Radius
+}
+
+func (c CircleOf[T]) Perimeter() T {
+	return 2 * T(math.Pi) * c.Radius
+}
+
+// Inertia is the moment of inertia about the centroid for a density
+func (c CircleOf[T]) Inertia(density T) T {
+	mass := density * c.Area()
+	return mass * c.Radius * c.Radius / 2
+}
+
+func (r RectangleOf[T]) Centroid() VectorOf[T] {
+	return edgeCentroid(r.Edges[:])
+}
+
+// SignedArea is negative, NewRectangle winds its edges CW
+func (r RectangleOf[T]) SignedArea() T {
+	return edgeWinding(r.Edges[:]) / 2
+}
+
+func (r RectangleOf[T]) Perimeter() T {
+	return edgePerimeter(r.Edges[:])
+}
+
+// Inertia is the moment of inertia about the centroid for a density
+func (r RectangleOf[T]) Inertia(density T) T {
+	w, h := r.Width(), r.Height()
+	mass := density * w * h
+	return mass * (w*w + h*h) / 12
+}
+
+func (t TriangleOf[T]) Centroid() VectorOf[T] {
+	return t.Edges[0].Start.Add(t.Edges[1].Start).Add(t.Edges[2].Start).Scale(1.0 / 3.0)
+}
+
+func (t TriangleOf[T]) SignedArea() T {
+	return edgeWinding(t.Edges[:]) / 2
+}
+
+func (t TriangleOf[T]) Perimeter() T {
+	return edgePerimeter(t.Edges[:])
+}
+
+// Inertia is the moment of inertia about the centroid for a density
+func (t TriangleOf[T]) Inertia(density T) T {
+	return edgeInertia(t.Edges[:], density)
+}
+
+// Centroid is the centre of area of p, which is not Position unless p has
+// been recentered
+func (p PolygonOf[T]) Centroid() VectorOf[T] {
+	return edgeCentroid(p.Edges)
+}
+
+// SignedArea is half the shoelace sum, positive for CCW polygons
+func (p PolygonOf[T]) SignedArea() T {
+	return edgeWinding(p.Edges) / 2
+}
+
+func (p PolygonOf[T]) Perimeter() T {
+	return edgePerimeter(p.Edges)
+}
+
+// Inertia is the moment of inertia about the centroid for a density
+func (p PolygonOf[T]) Inertia(density T) T {
+	return edgeInertia(p.Edges, density)
+}
+
+// Recenter moves Position onto the centroid and shifts rawEdges to match, the
+// world Edges stay where they are
+func (p PolygonOf[T]) Recenter() PolygonOf[T] {
+	centroid := p.Centroid()
+	offset := p.toLocal(centroid)
+
+	rawEdges := make([]EdgeOf[T], len(p.rawEdges))
+	for i, edge := range p.rawEdges {
+		rawEdges[i] = EdgeOf[T]{
+			Start:  edge.Start.Subtract(offset),
+			End:    edge.End.Subtract(offset),
+			Active: edge.Active,
+		}
+	}
+
+	p.Position = centroid
+	p.rawEdges = rawEdges
+	p.Edges = make([]EdgeOf[T], len(rawEdges))
+
+	return p.Update()
+}
+
+func edgeCentroid[T Float](edges []EdgeOf[T]) VectorOf[T] {
+	ring := make([]VectorOf[T], len(edges))
+	for i, edge := range edges {
+		ring[i] = edge.Start
+	}
+
+	return ringCentroid(ring)
+}
+
+func edgePerimeter[T Float](edges []EdgeOf[T]) T {
+	perimeter := T(0)
+	for _, edge := range edges {
+		perimeter += edge.Start.Distance(edge.End)
+	}
+
+	return perimeter
+}
+
+// edgeInertia sums the triangles fanned out from the centroid, either winding
+// gives the same result
+func edgeInertia[T Float](edges []EdgeOf[T], density T) T {
+	centroid := edgeCentroid(edges)
+
+	inertia := T(0)
+	for _, edge := range edges {
+		a := edge.Start.Subtract(centroid)
+		b := edge.End.Subtract(centroid)
+		inertia += a.CrossProduct(b) * (a.DotProduct(a) + a.DotProduct(b) + b.DotProduct(b))
+	}
+
+	return density * T(math.Abs(float64(inertia))) / 12
+}
+
+// ringCentroid is the centroid of the area of a ring, degenerate rings use
+// the average of their vertices
+func ringCentroid[T Float](ring []VectorOf[T]) VectorOf[T] {
+	area := T(0)
+	centroid := VectorOf[T]{}
+	for i := range ring {
+		a := ring[i]
+		b := ring[(i+1)%len(ring)]
+		cross := a.CrossProduct(b)
+
+		area += cross
+		centroid = centroid.Add(a.Add(b).Scale(cross))
+	}
+
+	if area == 0 {
+		average := VectorOf[T]{}
+		for _, v := range ring {
+			average = average.Add(v)
+		}
+		if len(ring) > 0 {
+			average = average.Scale(1 / T(len(ring)))
+		}
+		return average
+	}
+
+	return centroid.Scale(1 / (3 * area))
+}
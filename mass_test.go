@@ -0,0 +1,155 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+type massShape interface {
+	Centroid() mosaic.Vector
+	SignedArea() float64
+	Perimeter() float64
+	Inertia(density float64) float64
+}
+
+func Test_shape_MassProperties(t *testing.T) {
+	lShape := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(2, 0),
+			mosaic.NewVector(2, 1),
+			mosaic.NewVector(1, 1),
+			mosaic.NewVector(1, 2),
+			mosaic.NewVector(0, 2),
+		},
+	)
+	type input struct {
+		shape   massShape
+		density float64
+	}
+	type want struct {
+		centroid   mosaic.Vector
+		signedArea float64
+		perimeter  float64
+		inertia    float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "circle",
+			input: input{shape: mosaic.NewCircle(mosaic.NewVector(1, 2), 2), density: 2},
+			want: want{
+				centroid:   mosaic.NewVector(1, 2),
+				signedArea: 4 * math.Pi,
+				perimeter:  4 * math.Pi,
+				inertia:    16 * math.Pi,
+			},
+		},
+		{
+			name:  "rectangle",
+			input: input{shape: mosaic.NewRectangle(mosaic.NewVector(1, 1), 4, 2), density: 1},
+			want: want{
+				centroid:   mosaic.NewVector(1, 1),
+				signedArea: -8,
+				perimeter:  12,
+				inertia:    8 * 20.0 / 12,
+			},
+		},
+		{
+			name: "triangle",
+			input: input{
+				shape: mosaic.NewTriangle(
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(3, 0),
+					mosaic.NewVector(0, 3),
+				),
+				density: 1,
+			},
+			want: want{
+				centroid:   mosaic.NewVector(1, 1),
+				signedArea: 4.5,
+				perimeter:  6 + 3*math.Sqrt2,
+				inertia:    4.5 * (9 + 9) / 18,
+			},
+		},
+		{
+			name:  "square polygon",
+			input: input{shape: square(1, 1, 2), density: 3},
+			want: want{
+				centroid:   mosaic.NewVector(1, 1),
+				signedArea: 4,
+				perimeter:  8,
+				inertia:    12 * 8.0 / 12,
+			},
+		},
+		{
+			name:  "concave polygon",
+			input: input{shape: lShape, density: 1},
+			want: want{
+				centroid:   mosaic.NewVector(5.0/6, 5.0/6),
+				signedArea: 3,
+				perimeter:  8,
+				inertia:    6 - 3*2*(5.0/6)*(5.0/6),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.shape
+
+			if !WithinTolerance(got.Centroid().X, tt.want.centroid.X, 0.0001) ||
+				!WithinTolerance(got.Centroid().Y, tt.want.centroid.Y, 0.0001) {
+				t.Errorf("shape.Centroid() got = %v, want %v", got.Centroid(), tt.want.centroid)
+			}
+
+			if !WithinTolerance(got.SignedArea(), tt.want.signedArea, 0.0001) {
+				t.Errorf("shape.SignedArea() got = %v, want %v", got.SignedArea(), tt.want.signedArea)
+			}
+
+			if !WithinTolerance(got.Perimeter(), tt.want.perimeter, 0.0001) {
+				t.Errorf("shape.Perimeter() got = %v, want %v", got.Perimeter(), tt.want.perimeter)
+			}
+
+			inertia := got.Inertia(tt.input.density)
+			if !WithinTolerance(inertia, tt.want.inertia, 0.0001) {
+				t.Errorf("shape.Inertia() got = %v, want %v", inertia, tt.want.inertia)
+			}
+		})
+	}
+}
+
+func Test_polygon_Recenter(t *testing.T) {
+	p := mosaic.NewPolygon(
+		mosaic.NewVector(5, 5),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(2, 0),
+			mosaic.NewVector(2, 2),
+			mosaic.NewVector(0, 2),
+		},
+	)
+
+	got := p.Recenter()
+
+	if got.Position != mosaic.NewVector(6, 6) {
+		t.Errorf("polygon.Recenter() position = %v, want %v", got.Position, mosaic.NewVector(6, 6))
+	}
+
+	for i := range got.Edges {
+		if got.Edges[i].Start != p.Edges[i].Start {
+			t.Errorf("polygon.Recenter() edge %d = %v, want %v", i, got.Edges[i].Start, p.Edges[i].Start)
+		}
+	}
+
+	moved := got.SetPosition(mosaic.NewVector(0, 0))
+	if moved.Edges[0].Start != mosaic.NewVector(-1, -1) {
+		t.Errorf("polygon.Recenter() raw vertex = %v, want %v", moved.Edges[0].Start, mosaic.NewVector(-1, -1))
+	}
+}
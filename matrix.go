@@ -0,0 +1,228 @@
+package mosaic
+
+import "math"
+
+// matrixTolerance is how far a Matrix may stray from a rotation and uniform
+// scale and still convert to a Transform
+const matrixTolerance = 1e-9
+
+type (
+	// Matrix is a 2D affine transform acting on column vectors, the bottom
+	// row is 0 0 1 for every matrix built by this package
+	Matrix [3][3]float64
+)
+
+func IdentityMatrix() Matrix {
+	return Matrix{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}
+}
+
+func NewTranslationMatrix(x, y float64) Matrix {
+	return Matrix{
+		{1, 0, x},
+		{0, 1, y},
+		{0, 0, 1},
+	}
+}
+
+// NewRotationMatrix rotates CCW by angle in degrees
+func NewRotationMatrix(angle float64) Matrix {
+	r := math.Pi * angle / 180.0
+	sin, cos := math.Sin(r), math.Cos(r)
+
+	return Matrix{
+		{cos, -sin, 0},
+		{sin, cos, 0},
+		{0, 0, 1},
+	}
+}
+
+func NewScaleMatrix(x, y float64) Matrix {
+	return Matrix{
+		{x, 0, 0},
+		{0, y, 0},
+		{0, 0, 1},
+	}
+}
+
+// NewSkewMatrix shears along x by angle x and along y by angle y in degrees
+func NewSkewMatrix(x, y float64) Matrix {
+	return Matrix{
+		{1, math.Tan(math.Pi * x / 180.0), 0},
+		{math.Tan(math.Pi * y / 180.0), 1, 0},
+		{0, 0, 1},
+	}
+}
+
+// Multiply returns m * n, which applies n first and then m
+func (m Matrix) Multiply(n Matrix) Matrix {
+	result := Matrix{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				result[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+
+	return result
+}
+
+func (m Matrix) Determinant() float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// Inverse is false when m is singular
+func (m Matrix) Inverse() (Matrix, bool) {
+	determinant := m.Determinant()
+	if determinant == 0 {
+		return Matrix{}, false
+	}
+
+	// Transposed cofactors
+	inverse := Matrix{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			a := m[(j+1)%3][(i+1)%3] * m[(j+2)%3][(i+2)%3]
+			b := m[(j+1)%3][(i+2)%3] * m[(j+2)%3][(i+1)%3]
+			inverse[i][j] = (a - b) / determinant
+		}
+	}
+
+	return inverse, true
+}
+
+// Linear drops the translation from m
+func (m Matrix) Linear() Matrix {
+	m[0][2] = 0
+	m[1][2] = 0
+	return m
+}
+
+// ToTransform is false when m skews, scales unevenly or mirrors, which a
+// Transform cannot hold
+func (m Matrix) ToTransform() (Transform, bool) {
+	a, b, d, e := m[0][0], m[0][1], m[1][0], m[1][1]
+	scale := math.Hypot(a, d)
+	tolerance := matrixTolerance * math.Max(1, scale)
+
+	if scale == 0 || math.Abs(a-e) > tolerance || math.Abs(b+d) > tolerance {
+		return Transform{}, false
+	}
+
+	return Transform{
+		x:     m[0][2],
+		y:     m[1][2],
+		scale: scale,
+		sin:   d / scale,
+		cos:   a / scale,
+	}, true
+}
+
+// Matrix is the affine form of t, it maps vectors the same way
+// Vector.Transform does
+func (t Transform) Matrix() Matrix {
+	return Matrix{
+		{t.scale * t.cos, -t.scale * t.sin, t.x},
+		{t.scale * t.sin, t.scale * t.cos, t.y},
+		{0, 0, 1},
+	}
+}
+
+func (v VectorOf[T]) TransformMatrix(m Matrix) VectorOf[T] {
+	return VectorOf[T]{
+		X: T(m[0][0])*v.X + T(m[0][1])*v.Y + T(m[0][2]),
+		Y: T(m[1][0])*v.X + T(m[1][1])*v.Y + T(m[1][2]),
+	}
+}
+
+func (e EdgeOf[T]) TransformMatrix(m Matrix) EdgeOf[T] {
+	return EdgeOf[T]{
+		Start:  e.Start.TransformMatrix(m),
+		End:    e.End.TransformMatrix(m),
+		Active: e.Active,
+	}
+}
+
+// TransformMatrix maps r through m as a whole, Position moves with it and a
+// mirroring m keeps the winding of the edges
+func (r RectangleOf[T]) TransformMatrix(m Matrix) RectangleOf[T] {
+	r.Position = r.Position.TransformMatrix(m)
+	copy(r.rawEdges[:], transformEdgesMatrix(r.rawEdges[:], m.Linear()))
+
+	return r.Update()
+}
+
+// TransformMatrix maps t through m as a whole, Position moves with it. A
+// rotation and uniform scale are kept in Rotation, anything else is applied
+// to the raw vertices and Rotation is reset. A mirroring m keeps t CCW.
+func (t TriangleOf[T]) TransformMatrix(m Matrix) TriangleOf[T] {
+	t.Position = t.Position.TransformMatrix(m)
+
+	if similar, ok := m.Linear().ToTransform(); ok {
+		t.Rotation = T(math.Remainder(float64(t.Rotation)+similar.Angle(), 360))
+		copy(t.rawEdges[:], scaleEdges(t.rawEdges[:], T(similar.Scale())))
+		return t.Update()
+	}
+
+	linear := m.Linear().Multiply(NewRotationMatrix(float64(t.Rotation)))
+	copy(t.rawEdges[:], transformEdgesMatrix(t.rawEdges[:], linear))
+	t.Rotation = 0
+
+	return t.Update()
+}
+
+// TransformMatrix maps p through m as a whole, Position moves with it. A
+// rotation and uniform scale are kept in Rotation, anything else is applied
+// to the raw vertices and Rotation is reset. A mirroring m keeps p CCW.
+func (p PolygonOf[T]) TransformMatrix(m Matrix) PolygonOf[T] {
+	p.Position = p.Position.TransformMatrix(m)
+	p.Edges = make([]EdgeOf[T], len(p.rawEdges))
+
+	if similar, ok := m.Linear().ToTransform(); ok {
+		p.Rotation = T(math.Remainder(float64(p.Rotation)+similar.Angle(), 360))
+		p.rawEdges = scaleEdges(p.rawEdges, T(similar.Scale()))
+		return p.Update()
+	}
+
+	linear := m.Linear().Multiply(NewRotationMatrix(float64(p.Rotation)))
+	p.rawEdges = transformEdgesMatrix(p.rawEdges, linear)
+	p.Rotation = 0
+
+	return p.Update()
+}
+
+// transformEdgesMatrix reverses the ring when m mirrors it, the first edge
+// stays first so shapes that read their sides by index keep their layout
+func transformEdgesMatrix[T Float](edges []EdgeOf[T], m Matrix) []EdgeOf[T] {
+	n := len(edges)
+	result := make([]EdgeOf[T], n)
+	mirror := m.Determinant() < 0
+
+	for i, edge := range edges {
+		edge = edge.TransformMatrix(m)
+		if mirror {
+			edge.Start, edge.End = edge.End, edge.Start
+			result[(n-i)%n] = edge
+			continue
+		}
+		result[i] = edge
+	}
+
+	return result
+}
+
+// Apply transforms every vector into a new slice
+func (m Matrix) Apply(vectors []Vector) []Vector {
+	result := make([]Vector, len(vectors))
+	for i, v := range vectors {
+		result[i] = v.TransformMatrix(m)
+	}
+
+	return result
+}
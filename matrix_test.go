@@ -0,0 +1,233 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func matrixWithinTolerance(m, n mosaic.Matrix, tol float64) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(m[i][j]-n[i][j]) > tol {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func Test_matrix_Multiply(t *testing.T) {
+	type input struct {
+		m mosaic.Matrix
+		v mosaic.Vector
+	}
+	type want struct {
+		v           mosaic.Vector
+		determinant float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "identity",
+			input: input{m: mosaic.IdentityMatrix(), v: mosaic.NewVector(2, 3)},
+			want:  want{v: mosaic.NewVector(2, 3), determinant: 1},
+		},
+		{
+			name: "rotate then translate",
+			input: input{
+				m: mosaic.NewTranslationMatrix(1, 1).Multiply(mosaic.NewRotationMatrix(90)),
+				v: mosaic.NewVector(2, 0),
+			},
+			want: want{v: mosaic.NewVector(1, 3), determinant: 1},
+		},
+		{
+			name: "translate then rotate",
+			input: input{
+				m: mosaic.NewRotationMatrix(90).Multiply(mosaic.NewTranslationMatrix(1, 1)),
+				v: mosaic.NewVector(2, 0),
+			},
+			want: want{v: mosaic.NewVector(-1, 3), determinant: 1},
+		},
+		{
+			name:  "non-uniform scale",
+			input: input{m: mosaic.NewScaleMatrix(2, 3), v: mosaic.NewVector(1, 1)},
+			want:  want{v: mosaic.NewVector(2, 3), determinant: 6},
+		},
+		{
+			name:  "skew",
+			input: input{m: mosaic.NewSkewMatrix(45, 0), v: mosaic.NewVector(0, 2)},
+			want:  want{v: mosaic.NewVector(2, 2), determinant: 1},
+		},
+		{
+			name:  "mirror",
+			input: input{m: mosaic.NewScaleMatrix(-1, 1), v: mosaic.NewVector(1, 1)},
+			want:  want{v: mosaic.NewVector(-1, 1), determinant: -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.v.TransformMatrix(tt.input.m)
+			if !vectorWithinTolerance(got, tt.want.v, 0.0001) {
+				t.Errorf("vector.TransformMatrix() got = %v, want %v", got, tt.want.v)
+			}
+
+			determinant := tt.input.m.Determinant()
+			if !WithinTolerance(determinant, tt.want.determinant, 0.0001) {
+				t.Errorf("matrix.Determinant() got = %v, want %v", determinant, tt.want.determinant)
+			}
+
+			inverse, ok := tt.input.m.Inverse()
+			if !ok {
+				t.Fatalf("matrix.Inverse() got = %v, want %v", ok, true)
+			}
+
+			if !matrixWithinTolerance(tt.input.m.Multiply(inverse), mosaic.IdentityMatrix(), 0.0001) {
+				t.Errorf("matrix.Inverse() got = %v, want the inverse of %v", inverse, tt.input.m)
+			}
+		})
+	}
+}
+
+func Test_matrix_Inverse(t *testing.T) {
+	_, ok := mosaic.NewScaleMatrix(0, 1).Inverse()
+	if ok {
+		t.Errorf("matrix.Inverse() got = %v, want %v", ok, false)
+	}
+}
+
+func Test_matrix_ToTransform(t *testing.T) {
+	type input struct {
+		m mosaic.Matrix
+	}
+	type want struct {
+		ok bool
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "from transform",
+			input: input{m: mosaic.NewTransform(3, -2, 2, 30).Matrix()},
+			want:  want{ok: true},
+		},
+		{
+			name:  "non-uniform scale",
+			input: input{m: mosaic.NewScaleMatrix(2, 1)},
+			want:  want{ok: false},
+		},
+		{
+			name:  "skew",
+			input: input{m: mosaic.NewSkewMatrix(10, 0)},
+			want:  want{ok: false},
+		},
+		{
+			name:  "mirror",
+			input: input{m: mosaic.NewScaleMatrix(-1, 1)},
+			want:  want{ok: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.input.m.ToTransform()
+			if ok != tt.want.ok {
+				t.Fatalf("matrix.ToTransform() got = %v, want %v", ok, tt.want.ok)
+			}
+
+			if ok && !matrixWithinTolerance(got.Matrix(), tt.input.m, 0.0001) {
+				t.Errorf("matrix.ToTransform() got = %v, want %v", got.Matrix(), tt.input.m)
+			}
+
+			v := mosaic.NewVector(1, 2)
+			if ok && !vectorWithinTolerance(v.Transform(got), v.TransformMatrix(tt.input.m), 0.0001) {
+				t.Errorf("matrix.ToTransform() maps %v to %v, want %v", v, v.Transform(got), v.TransformMatrix(tt.input.m))
+			}
+		})
+	}
+}
+
+func Test_polygon_TransformMatrix(t *testing.T) {
+	type input struct {
+		polygon mosaic.Polygon
+		m       mosaic.Matrix
+	}
+	type want struct {
+		position mosaic.Vector
+		area     float64
+		inside   mosaic.Vector
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "scale about the origin",
+			input: input{polygon: square(1, 0, 2), m: mosaic.NewScaleMatrix(2, 1)},
+			want:  want{position: mosaic.NewVector(2, 0), area: 8, inside: mosaic.NewVector(3.5, 0)},
+		},
+		{
+			name:  "mirror keeps the winding",
+			input: input{polygon: square(1, 0, 2), m: mosaic.NewScaleMatrix(-1, 1)},
+			want:  want{position: mosaic.NewVector(-1, 0), area: 4, inside: mosaic.NewVector(-1.5, 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.polygon.TransformMatrix(tt.input.m)
+
+			if !vectorWithinTolerance(got.Position, tt.want.position, 0.0001) {
+				t.Errorf("polygon.TransformMatrix() position = %v, want %v", got.Position, tt.want.position)
+			}
+
+			if !WithinTolerance(got.Area(), tt.want.area, 0.0001) {
+				t.Errorf("polygon.TransformMatrix() area = %v, want %v", got.Area(), tt.want.area)
+			}
+
+			if got.SignedArea() <= 0 {
+				t.Errorf("polygon.TransformMatrix() signed area = %v, want CCW", got.SignedArea())
+			}
+
+			if !got.ContainsVector(tt.want.inside) {
+				t.Errorf("polygon.TransformMatrix() does not contain %v", tt.want.inside)
+			}
+
+			if tt.input.polygon.Position != mosaic.NewVector(1, 0) {
+				t.Errorf("polygon.TransformMatrix() changed the original polygon")
+			}
+		})
+	}
+}
+
+func Test_rectangle_TransformMatrix(t *testing.T) {
+	r := mosaic.NewRectangle(mosaic.NewVector(1, 1), 2, 2)
+	got := r.TransformMatrix(mosaic.NewTranslationMatrix(1, 0).Multiply(mosaic.NewRotationMatrix(90)))
+
+	if !vectorWithinTolerance(got.Position, mosaic.NewVector(0, 1), 0.0001) {
+		t.Errorf("rectangle.TransformMatrix() position = %v, want %v", got.Position, mosaic.NewVector(0, 1))
+	}
+
+	if !WithinTolerance(got.Area(), 4, 0.0001) {
+		t.Errorf("rectangle.TransformMatrix() area = %v, want %v", got.Area(), 4)
+	}
+}
+
+func Test_rectangle_TransformMatrix_mirror(t *testing.T) {
+	r := mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 2)
+	got := r.TransformMatrix(mosaic.NewScaleMatrix(-1, 1))
+
+	if math.Abs(got.Width()-4) > 0.0001 {
+		t.Errorf("rectangle.TransformMatrix() width = %v, want %v", got.Width(), 4)
+	}
+
+	if math.Abs(got.Height()-2) > 0.0001 {
+		t.Errorf("rectangle.TransformMatrix() height = %v, want %v", got.Height(), 2)
+	}
+}
@@ -0,0 +1,142 @@
+package mosaic
+
+import "math"
+
+// MinkowskiSum of two convex polygons, the result is positioned at the sum
+// of both positions
+func (p PolygonOf[T]) MinkowskiSum(q PolygonOf[T]) PolygonOf[T] {
+	return NewPolygon(
+		p.Position.Add(q.Position),
+		ConvertVectors[T](minkowskiSum(p.localRing(), q.localRing())),
+	)
+}
+
+// MinkowskiDifference of two convex polygons is the sum of p and q mirrored
+// through its position. It contains the origin when the polygons overlap.
+func (p PolygonOf[T]) MinkowskiDifference(q PolygonOf[T]) PolygonOf[T] {
+	mirrored := q.localRing()
+	for i := range mirrored {
+		mirrored[i] = mirrored[i].Invert()
+	}
+
+	return NewPolygon(
+		p.Position.Subtract(q.Position),
+		ConvertVectors[T](minkowskiSum(p.localRing(), mirrored)),
+	)
+}
+
+// MinkowskiSumCircle rounds every corner of a convex polygon by the circle's
+// radius. The arcs are approximated by tangent segments that enclose the true
+// curve and stray no more than tolerance outside of it.
+func (p PolygonOf[T]) MinkowskiSumCircle(c CircleOf[T], tolerance T) PolygonOf[T] {
+	return NewPolygon(
+		p.Position.Add(c.Position),
+		ConvertVectors[T](roundedRing(p.localRing(), float64(c.Radius), float64(tolerance))),
+	)
+}
+
+// MinkowskiDifferenceCircle is MinkowskiSumCircle with the circle mirrored
+// through the origin
+func (p PolygonOf[T]) MinkowskiDifferenceCircle(c CircleOf[T], tolerance T) PolygonOf[T] {
+	return NewPolygon(
+		p.Position.Subtract(c.Position),
+		ConvertVectors[T](roundedRing(p.localRing(), float64(c.Radius), float64(tolerance))),
+	)
+}
+
+// minkowskiSum merges the edges of two convex CCW rings by angle starting
+// from their lowest vertices
+func minkowskiSum(a, b []Vector) []Vector {
+	if len(a) == 0 || len(b) == 0 {
+		return []Vector{}
+	}
+
+	ia, ib := lowestVector(a), lowestVector(b)
+	n, m := len(a), len(b)
+	sum := make([]Vector, 0, n+m)
+
+	for i, j := 0, 0; i < n || j < m; {
+		sum = append(sum, a[(ia+i)%n].Add(b[(ib+j)%m]))
+
+		ea := a[(ia+i+1)%n].Subtract(a[(ia+i)%n])
+		eb := b[(ib+j+1)%m].Subtract(b[(ib+j)%m])
+		cross := ea.CrossProduct(eb)
+
+		switch {
+		case j == m || (i < n && cross > 0):
+			i++
+		case i == n || cross < 0:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return removeCollinear(sum)
+}
+
+func lowestVector(vectors []Vector) int {
+	lowest := 0
+	for i, v := range vectors {
+		if v.Y < vectors[lowest].Y || (v.Y == vectors[lowest].Y && v.X < vectors[lowest].X) {
+			lowest = i
+		}
+	}
+
+	return lowest
+}
+
+// roundedRing replaces every corner of a convex CCW ring with an arc, the
+// result always contains the exact rounded ring
+func roundedRing(ring []Vector, radius, tolerance float64) []Vector {
+	n := len(ring)
+	if radius <= 0 {
+		return ring
+	}
+
+	rounded := make([]Vector, 0, 4*n)
+	for i := 0; i < n; i++ {
+		prev := ring[(i-1+n)%n]
+		v := ring[i]
+		next := ring[(i+1)%n]
+
+		rounded = append(rounded, enclosingArc(v, prev.RightNormal(v), v.RightNormal(next), radius, tolerance)...)
+	}
+
+	return removeCollinear(rounded)
+}
+
+// enclosingArc approximates the shorter arc around center from u to w with
+// segments tangent to it. The ends stay on the arc so the straight sides are
+// exact, every vertex in between is pushed out to radius/cos(step/2).
+func enclosingArc(center, u, w Vector, radius, tolerance float64) []Vector {
+	start := math.Atan2(u.Y, u.X)
+	sweep := math.Atan2(u.CrossProduct(w), u.DotProduct(w))
+
+	if tolerance <= 0 {
+		tolerance = arcTolerance * radius
+	}
+
+	step := 2 * math.Acos(radius/(radius+tolerance))
+	steps := max(1, int(math.Ceil(math.Abs(sweep)/step)))
+	angle := sweep / float64(steps)
+	outer := radius / math.Cos(angle/2)
+
+	arc := make([]Vector, 0, steps+2)
+	arc = append(arc, center.Add(NewVector(math.Cos(start), math.Sin(start)).Scale(radius)))
+	for i := 0; i < steps; i++ {
+		a := start + angle*(float64(i)+0.5)
+		arc = append(arc, center.Add(NewVector(math.Cos(a), math.Sin(a)).Scale(outer)))
+	}
+	end := start + sweep
+	arc = append(arc, center.Add(NewVector(math.Cos(end), math.Sin(end)).Scale(radius)))
+
+	return arc
+}
+
+// localRing is the CCW ring of p relative to its Position with the rotation
+// applied, in float64 for the merging above
+func (p PolygonOf[T]) localRing() []Vector {
+	return relativeTo(ccwRing(ConvertEdges[float64](p.Edges)), ConvertVector[float64](p.Position))
+}
@@ -0,0 +1,139 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_MinkowskiSum(t *testing.T) {
+	triangle := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(2, 0),
+			mosaic.NewVector(0, 2),
+		},
+	)
+	tests := []struct {
+		name     string
+		p        mosaic.Polygon
+		q        mosaic.Polygon
+		position mosaic.Vector
+		vertices int
+		area     float64
+	}{
+		{
+			name:     "squares",
+			p:        square(1, 1, 2),
+			q:        square(2, 2, 4),
+			position: mosaic.NewVector(3, 3),
+			vertices: 4,
+			area:     36,
+		},
+		{
+			name:     "square and triangle",
+			p:        square(0, 0, 2),
+			q:        triangle,
+			position: mosaic.NewVector(0, 0),
+			vertices: 5,
+			area:     4 + 2 + 4 + 4,
+		},
+		{
+			name:     "clockwise input",
+			p:        mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2).ToPolygon(),
+			q:        triangle,
+			position: mosaic.NewVector(0, 0),
+			vertices: 5,
+			area:     4 + 2 + 4 + 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.p.MinkowskiSum(tt.q)
+
+			if got.Position != tt.position {
+				t.Errorf("polygon.MinkowskiSum() position = %v, want %v", got.Position, tt.position)
+			}
+
+			if len(got.Edges) != tt.vertices {
+				t.Errorf("polygon.MinkowskiSum() vertices = %v, want %v", len(got.Edges), tt.vertices)
+			}
+
+			if !got.IsConvex() {
+				t.Errorf("polygon.MinkowskiSum() is not convex")
+			}
+
+			if !WithinTolerance(got.Area(), tt.area, 1e-9) {
+				t.Errorf("polygon.MinkowskiSum() area = %v, want %v", got.Area(), tt.area)
+			}
+		})
+	}
+}
+
+func Test_polygon_MinkowskiDifference(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        mosaic.Polygon
+		q        mosaic.Polygon
+		contains bool
+	}{
+		{
+			name:     "overlapping",
+			p:        square(0, 0, 4),
+			q:        square(3, 1, 4),
+			contains: true,
+		},
+		{
+			name:     "apart",
+			p:        square(0, 0, 4),
+			q:        square(5, 1, 4),
+			contains: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.p.MinkowskiDifference(tt.q).ContainsVector(mosaic.Vector{})
+			if got != tt.contains {
+				t.Errorf("polygon.MinkowskiDifference() contains origin = %v, want %v", got, tt.contains)
+			}
+		})
+	}
+}
+
+func Test_polygon_MinkowskiSumCircle(t *testing.T) {
+	tolerance := 0.001
+	got := square(1, 1, 4).MinkowskiSumCircle(mosaic.NewCircle(mosaic.NewVector(1, 0), 1), tolerance)
+
+	if got.Position != mosaic.NewVector(2, 1) {
+		t.Errorf("polygon.MinkowskiSumCircle() position = %v, want %v", got.Position, mosaic.NewVector(2, 1))
+	}
+
+	want := 16 + 4*4 + math.Pi
+	if math.Abs(got.Area()-want) > 2*math.Pi*tolerance {
+		t.Errorf("polygon.MinkowskiSumCircle() area = %v, want %v", got.Area(), want)
+	}
+
+	if got.Area() < want {
+		t.Errorf("polygon.MinkowskiSumCircle() area = %v, want at least %v", got.Area(), want)
+	}
+
+	for quadrant := 0; quadrant < 4; quadrant++ {
+		start := float64(quadrant) * math.Pi / 2
+		corner := got.Position.Add(mosaic.NewVector(math.Cos(start+math.Pi/4), math.Sin(start+math.Pi/4)).Scale(2 * math.Sqrt2))
+		for i := 0; i <= 16; i++ {
+			angle := start + math.Pi/2*float64(i)/16
+			v := corner.Add(mosaic.NewVector(math.Cos(angle), math.Sin(angle)).Scale(1 - 1e-9))
+			if !got.ContainsVector(v) {
+				t.Errorf("polygon.MinkowskiSumCircle() does not contain %v", v)
+			}
+		}
+	}
+
+	for _, edge := range got.Edges {
+		if !edge.ContainsVector(got.Position) {
+			t.Errorf("polygon.MinkowskiSumCircle() edge %v is not CCW", edge)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package mosaic_test
 
-import "math"
+import (
+	"math"
+
+	"github.com/maladroitthief/mosaic"
+)
 
 func WithinTolerance(x, y, tolerance float64) bool {
 	if x == y {
@@ -14,3 +18,19 @@ func WithinTolerance(x, y, tolerance float64) bool {
 
 	return (delta / math.Abs(y)) < tolerance
 }
+
+func vectorWithinTolerance(v, w mosaic.Vector, tolerance float64) bool {
+	return math.Abs(v.X-w.X) < tolerance && math.Abs(v.Y-w.Y) < tolerance
+}
+
+func square(x, y, size float64) mosaic.Polygon {
+	return mosaic.NewPolygon(
+		mosaic.NewVector(x, y),
+		[]mosaic.Vector{
+			mosaic.NewVector(-size/2, -size/2),
+			mosaic.NewVector(size/2, -size/2),
+			mosaic.NewVector(size/2, size/2),
+			mosaic.NewVector(-size/2, size/2),
+		},
+	)
+}
@@ -0,0 +1,160 @@
+package mosaic
+
+import "math"
+
+type (
+	// Node places an optional shape in a hierarchy of transforms. World
+	// transforms and world shapes are only rebuilt when something above them
+	// has changed since they were last read.
+	Node struct {
+		local    Transform
+		world    Transform
+		shape    Shape
+		placed   Shape
+		parent   *Node
+		children []*Node
+		dirty    bool
+	}
+)
+
+// NewNode accepts a shape in the node's local space, shape may be nil
+func NewNode(local Transform, shape Shape) *Node {
+	return &Node{
+		local: local,
+		shape: shape,
+		dirty: true,
+	}
+}
+
+func (n *Node) Parent() *Node {
+	return n.parent
+}
+
+func (n *Node) Children() []*Node {
+	return n.children
+}
+
+// AddChild moves child under n, taking it away from any previous parent
+func (n *Node) AddChild(child *Node) {
+	if child.parent != nil {
+		child.parent.RemoveChild(child)
+	}
+
+	child.parent = n
+	n.children = append(n.children, child)
+	child.invalidate()
+}
+
+func (n *Node) RemoveChild(child *Node) {
+	for i, c := range n.children {
+		if c == child {
+			n.children = append(n.children[:i], n.children[i+1:]...)
+			child.parent = nil
+			child.invalidate()
+			return
+		}
+	}
+}
+
+func (n *Node) Local() Transform {
+	return n.local
+}
+
+func (n *Node) SetLocal(local Transform) {
+	n.local = local
+	n.invalidate()
+}
+
+func (n *Node) SetShape(shape Shape) {
+	n.shape = shape
+	n.placed = nil
+}
+
+// World is the local transform of n composed with every parent above it
+func (n *Node) World() Transform {
+	n.refresh()
+	return n.world
+}
+
+// Shape is the node's shape placed in world space, or nil
+func (n *Node) Shape() Shape {
+	n.refresh()
+	if n.placed == nil && n.shape != nil {
+		n.placed = transformShape(n.shape, n.world)
+	}
+
+	return n.placed
+}
+
+// Walk visits n and then its descendants depth first
+func (n *Node) Walk(visit func(*Node)) {
+	visit(n)
+	for _, child := range n.children {
+		child.Walk(visit)
+	}
+}
+
+func (n *Node) refresh() {
+	if !n.dirty {
+		return
+	}
+
+	n.world = n.local
+	if n.parent != nil {
+		n.world = n.parent.World().Compose(n.local)
+	}
+
+	n.placed = nil
+	n.dirty = false
+}
+
+// invalidate marks n and its descendants, stopping early at subtrees that
+// are already waiting to refresh
+func (n *Node) invalidate() {
+	if n.dirty {
+		return
+	}
+
+	n.dirty = true
+	for _, child := range n.children {
+		child.invalidate()
+	}
+}
+
+// transformShape maps a local shape into world space through t
+func transformShape(s Shape, t Transform) Shape {
+	m := t.Matrix()
+
+	switch shape := s.(type) {
+	case Circle:
+		shape.Position = shape.Position.Transform(t)
+		shape.Radius = shape.Radius * t.scale
+		shape.Rotation = math.Remainder(shape.Rotation+t.Angle(), 360)
+		return shape.Update()
+	case Rectangle:
+		return shape.TransformMatrix(m)
+	case Triangle:
+		return shape.TransformMatrix(m)
+	case Polygon:
+		return shape.TransformMatrix(m)
+	case Compound:
+		pieces := make([]Polygon, len(shape.Pieces))
+		for i, piece := range shape.Pieces {
+			pieces[i] = piece.TransformMatrix(m)
+		}
+		shape.Pieces = pieces
+		shape.Position = shape.Position.TransformMatrix(m)
+		return shape.Update()
+	case Region:
+		holes := make([]Polygon, len(shape.Holes))
+		for i, hole := range shape.Holes {
+			holes[i] = hole.TransformMatrix(m)
+		}
+		shape.Outer = shape.Outer.TransformMatrix(m)
+		shape.Holes = holes
+		shape.Position = shape.Position.TransformMatrix(m)
+		return shape
+	}
+
+	return s
+}
@@ -0,0 +1,118 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_node_World(t *testing.T) {
+	type input struct {
+		ship   mosaic.Transform
+		turret mosaic.Transform
+	}
+	type want struct {
+		position mosaic.Vector
+		angle    float64
+		corner   mosaic.Vector
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "translated",
+			input: input{
+				ship:   mosaic.NewTransform(10, 0, 1, 0),
+				turret: mosaic.NewTransform(1, 0, 1, 0),
+			},
+			want: want{position: mosaic.NewVector(11, 0), angle: 0, corner: mosaic.NewVector(10.5, -0.5)},
+		},
+		{
+			name: "ship rotated",
+			input: input{
+				ship:   mosaic.NewTransform(10, 0, 1, 90),
+				turret: mosaic.NewTransform(1, 0, 1, 0),
+			},
+			want: want{position: mosaic.NewVector(10, 1), angle: 90, corner: mosaic.NewVector(10.5, 0.5)},
+		},
+		{
+			name: "ship scaled and turret rotated",
+			input: input{
+				ship:   mosaic.NewTransform(0, 0, 2, 0),
+				turret: mosaic.NewTransform(1, 1, 1, 45),
+			},
+			want: want{position: mosaic.NewVector(2, 2), angle: 45, corner: mosaic.NewVector(2, 0.5857864376269049)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ship := mosaic.NewNode(tt.input.ship, nil)
+			turret := mosaic.NewNode(tt.input.turret, square(0, 0, 1))
+			ship.AddChild(turret)
+
+			got := turret.World()
+			if !vectorWithinTolerance(got.Position(), tt.want.position, 0.0001) {
+				t.Errorf("node.World() position = %v, want %v", got.Position(), tt.want.position)
+			}
+
+			if !WithinTolerance(got.Angle(), tt.want.angle, 0.0001) {
+				t.Errorf("node.World() angle = %v, want %v", got.Angle(), tt.want.angle)
+			}
+
+			shape := turret.Shape().(mosaic.Polygon)
+			if !vectorWithinTolerance(shape.Position, tt.want.position, 0.0001) {
+				t.Errorf("node.Shape() position = %v, want %v", shape.Position, tt.want.position)
+			}
+
+			if !vectorWithinTolerance(shape.Edges[0].Start, tt.want.corner, 0.0001) {
+				t.Errorf("node.Shape() corner = %v, want %v", shape.Edges[0].Start, tt.want.corner)
+			}
+
+			if ship.Shape() != nil {
+				t.Errorf("node.Shape() got = %v, want nil", ship.Shape())
+			}
+		})
+	}
+}
+
+func Test_node_SetLocal(t *testing.T) {
+	root := mosaic.NewNode(mosaic.NewTransform(0, 0, 1, 0), nil)
+	ship := mosaic.NewNode(mosaic.NewTransform(5, 0, 1, 0), nil)
+	turret := mosaic.NewNode(mosaic.NewTransform(0, 1, 1, 0), mosaic.NewCircle(mosaic.NewVector(0, 0), 1))
+	root.AddChild(ship)
+	ship.AddChild(turret)
+
+	before := turret.Shape().(mosaic.Circle)
+	if before.Position != mosaic.NewVector(5, 1) {
+		t.Errorf("node.Shape() position = %v, want %v", before.Position, mosaic.NewVector(5, 1))
+	}
+
+	root.SetLocal(mosaic.NewTransform(0, 10, 2, 0))
+	after := turret.Shape().(mosaic.Circle)
+	if after.Position != mosaic.NewVector(10, 12) || after.Radius != 2 {
+		t.Errorf("node.Shape() got = %v %v, want %v %v", after.Position, after.Radius, mosaic.NewVector(10, 12), 2)
+	}
+
+	if after.Bounds().Position != mosaic.NewVector(10, 12) {
+		t.Errorf("node.Shape() bounds = %v, want %v", after.Bounds().Position, mosaic.NewVector(10, 12))
+	}
+
+	// Reparenting drops the ship's transform
+	root.AddChild(turret)
+	if len(ship.Children()) != 0 || turret.Parent() != root {
+		t.Errorf("node.AddChild() did not move the turret")
+	}
+
+	moved := turret.Shape().(mosaic.Circle)
+	if moved.Position != mosaic.NewVector(0, 12) {
+		t.Errorf("node.Shape() position = %v, want %v", moved.Position, mosaic.NewVector(0, 12))
+	}
+
+	visited := 0
+	root.Walk(func(*mosaic.Node) { visited++ })
+	if visited != 3 {
+		t.Errorf("node.Walk() visited = %v, want %v", visited, 3)
+	}
+}
@@ -0,0 +1,196 @@
+package mosaic
+
+import (
+	"math"
+	"sort"
+)
+
+// arcTolerance is how far a round join may stray from the true arc, as a
+// fraction of the offset distance
+const arcTolerance = 0.0025
+
+type JoinStyle int
+
+const (
+	MiterJoin JoinStyle = iota
+	RoundJoin
+	SquareJoin
+)
+
+// Offset grows p by distance, or shrinks it when distance is negative. Miter
+// joins longer than miterLimit times the distance are squared off, a limit
+// of zero uses 2. Shrinking may split p into several regions or remove it
+// entirely, the results share p's Position.
+func (p PolygonOf[T]) Offset(distance T, join JoinStyle, miterLimit T) []RegionOf[T] {
+	return ConvertRegions[T](offset(ConvertPolygon[float64](p), float64(distance), join, float64(miterLimit)))
+}
+
+func offset(p Polygon, distance float64, join JoinStyle, miterLimit float64) []Region {
+	ring := ccwRing(p.Edges)
+	if len(ring) < 3 {
+		return []Region{}
+	}
+
+	if distance == 0 {
+		return []Region{NewRegion(NewPolygon(p.Position, relativeTo(ring, p.Position)))}
+	}
+
+	if miterLimit <= 0 {
+		miterLimit = 2
+	}
+
+	raw := offsetRing(ring, distance, join, miterLimit)
+	return buildRegions(p.Position, linkRings(windingUnion([][]Vector{raw})))
+}
+
+// offsetRing moves every edge of a CCW ring along its outward normal and
+// joins the gaps at the corners. Concave corners are left overlapping with
+// the vertex itself in between so windingUnion can clean them up.
+func offsetRing(ring []Vector, distance float64, join JoinStyle, miterLimit float64) []Vector {
+	n := len(ring)
+	d := math.Abs(distance)
+	raw := make([]Vector, 0, 3*n)
+
+	for i := 0; i < n; i++ {
+		prev := ring[(i-1+n)%n]
+		v := ring[i]
+		next := ring[(i+1)%n]
+
+		in := v.Subtract(prev).Normalize()
+		out := next.Subtract(v).Normalize()
+		o1 := prev.RightNormal(v).Scale(distance)
+		o2 := v.RightNormal(next).Scale(distance)
+
+		// The gap only opens on the outside of the turn
+		if in.CrossProduct(out)*distance <= 0 {
+			raw = append(raw, v.Add(o1), v, v.Add(o2))
+			continue
+		}
+
+		u1 := o1.Normalize()
+		u2 := o2.Normalize()
+		cos := u1.DotProduct(u2)
+
+		switch join {
+		case RoundJoin:
+			raw = append(raw, arcVectors(v, u1, u2, d, arcTolerance*d)...)
+		case MiterJoin, SquareJoin:
+			if join == MiterJoin && math.Sqrt(2/(1+cos)) <= miterLimit {
+				raw = append(raw, v.Add(u1.Add(u2).Scale(d/(1+cos))))
+				continue
+			}
+
+			// Cut the corner with a line distance away along the bisector
+			bisector := u1.Add(u2).Normalize()
+			if bisector == (Vector{}) {
+				bisector = in
+			}
+			s1 := (d - o1.DotProduct(bisector)) / in.DotProduct(bisector)
+			s2 := (d - o2.DotProduct(bisector)) / out.DotProduct(bisector)
+			raw = append(raw, v.Add(o1).Add(in.Scale(s1)), v.Add(o2).Add(out.Scale(s2)))
+		}
+	}
+
+	return raw
+}
+
+// arcVectors approximates the shorter arc around center from u to w with
+// chords that stay within tolerance of it
+func arcVectors(center, u, w Vector, radius, tolerance float64) []Vector {
+	start := math.Atan2(u.Y, u.X)
+	sweep := math.Atan2(u.CrossProduct(w), u.DotProduct(w))
+
+	if tolerance <= 0 {
+		tolerance = arcTolerance * radius
+	}
+
+	step := math.Pi
+	if tolerance < radius {
+		step = 2 * math.Acos(1-tolerance/radius)
+	}
+	steps := max(1, int(math.Ceil(math.Abs(sweep)/step)))
+
+	arc := make([]Vector, 0, steps+1)
+	for i := 0; i <= steps; i++ {
+		angle := start + sweep*float64(i)/float64(steps)
+		arc = append(arc, center.Add(NewVector(math.Cos(angle), math.Sin(angle)).Scale(radius)))
+	}
+
+	return arc
+}
+
+// windingUnion resolves rings that overlap themselves or each other into
+// the boundary of the area with a positive winding number
+func windingUnion(rings [][]Vector) []Edge {
+	fragments := []Edge{}
+	for i, ring := range rings {
+		ring = removeCollinear(ring)
+		rings[i] = ring
+
+		for j := range ring {
+			fragments = append(fragments, Edge{Start: ring[j], End: ring[(j+1)%len(ring)]})
+		}
+	}
+
+	type split struct {
+		t float64
+		v Vector
+	}
+	splits := make([][]split, len(fragments))
+	for i := range fragments {
+		for j := i + 1; j < len(fragments); j++ {
+			for _, x := range segmentSplits(
+				fragments[i].Start, fragments[i].End,
+				fragments[j].Start, fragments[j].End,
+			) {
+				splits[i] = append(splits[i], split{t: x.t, v: x.v})
+				splits[j] = append(splits[j], split{t: x.u, v: x.v})
+			}
+		}
+	}
+
+	seen := make(map[Edge]bool)
+	kept := []Edge{}
+	for i, fragment := range fragments {
+		points := append(splits[i], split{t: 0, v: fragment.Start}, split{t: 1, v: fragment.End})
+		sort.SliceStable(points, func(a, b int) bool {
+			return points[a].t < points[b].t
+		})
+
+		for k := 1; k < len(points); k++ {
+			start, end := points[k-1].v, points[k].v
+			if start == end {
+				continue
+			}
+
+			edge := Edge{Start: start, End: end, Active: true}
+			if seen[edge] {
+				continue
+			}
+			seen[edge] = true
+
+			midpoint := start.Add(end).Scale(0.5)
+			side := start.RightNormal(end).Scale(start.Distance(end) * 1e-6)
+			right := windingNumber(rings, midpoint.Add(side))
+			left := windingNumber(rings, midpoint.Subtract(side))
+
+			if left > 0 && right <= 0 {
+				kept = append(kept, edge)
+			}
+		}
+	}
+
+	return kept
+}
+
+// windingNumber counts how many times the rings wind around v, CCW positive
+func windingNumber(rings [][]Vector, v Vector) int {
+	winding := 0
+	for _, ring := range rings {
+		for i := range ring {
+			winding += Edge{Start: ring[i], End: ring[(i+1)%len(ring)]}.Winding(v)
+		}
+	}
+
+	return winding
+}
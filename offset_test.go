@@ -0,0 +1,102 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_Offset(t *testing.T) {
+	dumbbell := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(4, 0),
+			mosaic.NewVector(4, 1.5),
+			mosaic.NewVector(6, 1.5),
+			mosaic.NewVector(6, 0),
+			mosaic.NewVector(10, 0),
+			mosaic.NewVector(10, 4),
+			mosaic.NewVector(6, 4),
+			mosaic.NewVector(6, 2.5),
+			mosaic.NewVector(4, 2.5),
+			mosaic.NewVector(4, 4),
+			mosaic.NewVector(0, 4),
+		},
+	)
+	type input struct {
+		polygon    mosaic.Polygon
+		distance   float64
+		join       mosaic.JoinStyle
+		miterLimit float64
+	}
+	type want struct {
+		regions int
+		area    float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "miter",
+			input: input{polygon: square(0, 0, 4), distance: 1, join: mosaic.MiterJoin},
+			want:  want{regions: 1, area: 36},
+		},
+		{
+			name:  "square",
+			input: input{polygon: square(0, 0, 4), distance: 1, join: mosaic.SquareJoin},
+			want:  want{regions: 1, area: 36 - 4*(1-math.Sqrt2/2)*(1-math.Sqrt2/2)*2},
+		},
+		{
+			name:  "round",
+			input: input{polygon: square(0, 0, 4), distance: 1, join: mosaic.RoundJoin},
+			want:  want{regions: 1, area: 32 + math.Pi},
+		},
+		{
+			name:  "miter limit squares the corner",
+			input: input{polygon: square(0, 0, 4), distance: 1, join: mosaic.MiterJoin, miterLimit: 1.2},
+			want:  want{regions: 1, area: 36 - 4*(1-math.Sqrt2/2)*(1-math.Sqrt2/2)*2},
+		},
+		{
+			name:  "shrink",
+			input: input{polygon: square(0, 0, 4), distance: -1, join: mosaic.MiterJoin},
+			want:  want{regions: 1, area: 4},
+		},
+		{
+			name:  "shrink away",
+			input: input{polygon: square(0, 0, 4), distance: -3, join: mosaic.RoundJoin},
+			want:  want{regions: 0, area: 0},
+		},
+		{
+			name:  "shrink splits",
+			input: input{polygon: dumbbell, distance: -1, join: mosaic.MiterJoin},
+			want:  want{regions: 2, area: 8},
+		},
+		{
+			name:  "grow fills the notch",
+			input: input{polygon: dumbbell, distance: 1, join: mosaic.MiterJoin},
+			want:  want{regions: 1, area: 12 * 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.polygon.Offset(tt.input.distance, tt.input.join, tt.input.miterLimit)
+
+			if len(got) != tt.want.regions {
+				t.Fatalf("polygon.Offset() regions = %v, want %v", len(got), tt.want.regions)
+			}
+
+			area := 0.0
+			for _, region := range got {
+				area += region.Area()
+			}
+
+			if math.Abs(area-tt.want.area) > 0.01*math.Max(1, tt.want.area) {
+				t.Errorf("polygon.Offset() area = %v, want %v", area, tt.want.area)
+			}
+		})
+	}
+}
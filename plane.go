@@ -1,26 +1,30 @@
 package mosaic
 
-type Plane struct {
-	Normal Vector
-	// Distance from the origin
-	Distance float64
-}
+type (
+	PlaneOf[T Float] struct {
+		Normal VectorOf[T]
+		// Distance from the origin
+		Distance T
+	}
+
+	Plane = PlaneOf[float64]
+)
 
-func NewPlane(v, w Vector) Plane {
+func NewPlane[T Float](v, w VectorOf[T]) PlaneOf[T] {
 	normal := v.RightNormal(w)
 	distance := normal.DotProduct(w)
 
-	return Plane{
+	return PlaneOf[T]{
 		Normal:   normal,
 		Distance: distance,
 	}
 }
 
-func (p Plane) DistanceTo(v Vector) float64 {
+func (p PlaneOf[T]) DistanceTo(v VectorOf[T]) T {
 	return p.Normal.DotProduct(v) - p.Distance
 }
 
-func (p Plane) Invert() Plane {
+func (p PlaneOf[T]) Invert() PlaneOf[T] {
 	p.Normal.Scale(-1)
 	p.Distance = p.Distance * -1
 	return p
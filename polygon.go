@@ -6,26 +6,28 @@ import (
 )
 
 type (
-	Polygon struct {
-		Position Vector
-		rawEdges []Edge
-		Edges    []Edge
-		Planes   []Plane
-		Bounds   Rectangle
-		Rotation float64
+	PolygonOf[T Float] struct {
+		Position VectorOf[T]
+		rawEdges []EdgeOf[T]
+		Edges    []EdgeOf[T]
+		Planes   []PlaneOf[T]
+		bounds   RectangleOf[T]
+		Rotation T
 	}
+
+	Polygon = PolygonOf[float64]
 )
 
 // NewPolygon accepts an array of vectors in CCW rotation
-func NewPolygon(position Vector, vectors []Vector) Polygon {
-	p := Polygon{
+func NewPolygon[T Float](position VectorOf[T], vectors []VectorOf[T]) PolygonOf[T] {
+	p := PolygonOf[T]{
 		Position: position,
-		rawEdges: make([]Edge, len(vectors)),
-		Edges:    make([]Edge, len(vectors)),
+		rawEdges: make([]EdgeOf[T], len(vectors)),
+		Edges:    make([]EdgeOf[T], len(vectors)),
 	}
 
 	for i := 0; i < len(vectors); i++ {
-		p.rawEdges[i] = Edge{
+		p.rawEdges[i] = EdgeOf[T]{
 			Start:  vectors[i],
 			End:    vectors[(i+1)%len(vectors)],
 			Active: true,
@@ -35,32 +37,73 @@ func NewPolygon(position Vector, vectors []Vector) Polygon {
 	return p.Update()
 }
 
-func (p Polygon) Info() string {
+// ConvertPolygon changes the precision of p, a polygon that is already in U
+// is returned as is
+func ConvertPolygon[U, T Float](p PolygonOf[T]) PolygonOf[U] {
+	if same, ok := any(p).(PolygonOf[U]); ok {
+		return same
+	}
+
+	return PolygonOf[U]{
+		Position: ConvertVector[U](p.Position),
+		rawEdges: ConvertEdges[U](p.rawEdges),
+		Edges:    make([]EdgeOf[U], len(p.rawEdges)),
+		Rotation: U(p.Rotation),
+	}.Update()
+}
+
+// ConvertPolygons changes the precision of every polygon into a new slice, a
+// slice that is already in U is returned as is
+func ConvertPolygons[U, T Float](polygons []PolygonOf[T]) []PolygonOf[U] {
+	if same, ok := any(polygons).([]PolygonOf[U]); ok {
+		return same
+	}
+
+	result := make([]PolygonOf[U], len(polygons))
+	for i, p := range polygons {
+		result[i] = ConvertPolygon[U](p)
+	}
+
+	return result
+}
+
+func (p PolygonOf[T]) Info() string {
 	return fmt.Sprintf("%+v, %+v", p.Position, p.Edges)
 }
 
-func (p Polygon) Update() Polygon {
+func (p PolygonOf[T]) Update() PolygonOf[T] {
 	p.Edges = p.calcEdges()
 	p.Planes = p.calcPlanes()
-	p.Bounds = p.calcBounds()
+	p.bounds = p.calcBounds()
 	return p
 }
 
-func (p Polygon) Copy(q Polygon) Polygon {
+func (p PolygonOf[T]) Type() ShapeType {
+	return PolygonShape
+}
+
+func (p PolygonOf[T]) Bounds() RectangleOf[T] {
+	return p.bounds
+}
+
+func (p PolygonOf[T]) Copy(q PolygonOf[T]) PolygonOf[T] {
 	position := q.Position.Clone()
-	vectors := make([]Vector, len(q.rawEdges))
+	vectors := make([]VectorOf[T], len(q.rawEdges))
 	for i := 0; i < len(vectors); i++ {
 		vectors[i] = q.rawEdges[i].Start.Clone()
 	}
 
-	return NewPolygon(position, vectors)
+	r := NewPolygon(position, vectors)
+	r.Rotation = q.Rotation
+
+	return r.Update()
 }
 
-func (p Polygon) Clone() Polygon {
+func (p PolygonOf[T]) Clone() PolygonOf[T] {
 	return p.Copy(p)
 }
 
-func (p Polygon) SetEdge(start, end Vector, active bool) Polygon {
+func (p PolygonOf[T]) SetEdge(start, end VectorOf[T], active bool) PolygonOf[T] {
 	for i := range p.rawEdges {
 		if p.rawEdges[i].Start == start && p.rawEdges[i].End == end {
 			p.rawEdges[i].Active = active
@@ -71,11 +114,11 @@ func (p Polygon) SetEdge(start, end Vector, active bool) Polygon {
 	return p.Update()
 }
 
-func (p Polygon) CheckPosition(position Vector) Polygon {
+func (p PolygonOf[T]) CheckPosition(position VectorOf[T]) PolygonOf[T] {
 	return p.Clone().SetPosition(position)
 }
 
-func (p Polygon) SetPosition(position Vector) Polygon {
+func (p PolygonOf[T]) SetPosition(position VectorOf[T]) PolygonOf[T] {
 	if p.Position == position {
 		return p
 	}
@@ -85,13 +128,59 @@ func (p Polygon) SetPosition(position Vector) Polygon {
 	return p.Update()
 }
 
-func (p Polygon) Add(v Vector) Polygon {
+// Transform moves p by the translation of t, then turns it about its
+// Position by the rotation of t and scales it. The rotation is kept in
+// Rotation rather than applied to the raw vertices.
+func (p PolygonOf[T]) Transform(t Transform) PolygonOf[T] {
+	p.Position = p.Position.Add(ConvertVector[T](t.Position()))
+	p.Rotation = T(math.Remainder(float64(p.Rotation)+t.Angle(), 360))
+	p.rawEdges = scaleEdges(p.rawEdges, T(t.Scale()))
+	p.Edges = make([]EdgeOf[T], len(p.rawEdges))
+
+	return p.Update()
+}
+
+// Rotate turns p about its Position by angle in degrees
+func (p PolygonOf[T]) Rotate(angle T) PolygonOf[T] {
+	return p.SetRotation(p.Rotation + angle)
+}
+
+// SetRotation sets the angle in degrees p is turned by about its Position
+func (p PolygonOf[T]) SetRotation(angle T) PolygonOf[T] {
+	p.Rotation = T(math.Remainder(float64(angle), 360))
+	p.Edges = make([]EdgeOf[T], len(p.rawEdges))
+
+	return p.Update()
+}
+
+// Scale grows p about its Position
+func (p PolygonOf[T]) Scale(c T) PolygonOf[T] {
+	p.rawEdges = scaleEdges(p.rawEdges, c)
+	p.Edges = make([]EdgeOf[T], len(p.rawEdges))
+
+	return p.Update()
+}
+
+// toLocal maps a world vector into the frame of the raw vertices
+func (p PolygonOf[T]) toLocal(v VectorOf[T]) VectorOf[T] {
+	return v.Subtract(p.Position).Transform(NewTransform(0, 0, 1, -float64(p.Rotation)))
+}
+
+// reshape builds a polygon from raw vertices in the frame of p
+func (p PolygonOf[T]) reshape(vectors []VectorOf[T]) PolygonOf[T] {
+	q := NewPolygon(p.Position, vectors)
+	q.Rotation = p.Rotation
+
+	return q.Update()
+}
+
+func (p PolygonOf[T]) Add(v VectorOf[T]) PolygonOf[T] {
 	q := p.Clone()
 	q.Position = q.Position.Add(v)
 	return q.Update()
 }
 
-func (p Polygon) ContainsVector(v Vector) bool {
+func (p PolygonOf[T]) ContainsVector(v VectorOf[T]) bool {
 	rayCount := 0
 	for i := 0; i < len(p.Edges); i++ {
 		rayCount += p.Edges[i].RayCount(v)
@@ -100,18 +189,18 @@ func (p Polygon) ContainsVector(v Vector) bool {
 	return rayCount%2 == 1
 }
 
-func (p Polygon) Intersects(q Polygon) (normal Vector, depth float64) {
-	depth = math.MaxFloat64
+func (p PolygonOf[T]) Intersects(q PolygonOf[T]) (normal VectorOf[T], depth T) {
+	depth = T(math.Inf(1))
 
 	for _, plane := range p.Planes {
 		minP, maxP := p.projectVectors(plane.Normal)
 		minQ, maxQ := q.projectVectors(plane.Normal)
 
 		if minP >= maxQ || minQ >= maxP {
-			return Vector{}, 0.0
+			return VectorOf[T]{}, 0.0
 		}
 
-		planeDistance := math.Min(maxQ-minP, maxP-minQ)
+		planeDistance := min(maxQ-minP, maxP-minQ)
 		if planeDistance < depth {
 			depth = planeDistance
 			normal = plane.Normal
@@ -123,10 +212,10 @@ func (p Polygon) Intersects(q Polygon) (normal Vector, depth float64) {
 		minQ, maxQ := q.projectVectors(plane.Normal)
 
 		if minP >= maxQ || minQ >= maxP {
-			return Vector{}, 0.0
+			return VectorOf[T]{}, 0.0
 		}
 
-		planeDistance := math.Min(maxQ-minP, maxP-minQ)
+		planeDistance := min(maxQ-minP, maxP-minQ)
 		if planeDistance < depth {
 			depth = planeDistance
 			normal = plane.Normal
@@ -140,12 +229,18 @@ func (p Polygon) Intersects(q Polygon) (normal Vector, depth float64) {
 	return normal, depth
 }
 
-func (p Polygon) ContainsPolygon(q Polygon) (normal Vector, depth float64) {
-	depth = math.MaxFloat64
-	xDepth := math.MaxFloat64
-	xNormal := Vector{}
-	yDepth := math.MaxFloat64
-	yNormal := Vector{}
+// IntersectsCircle returns a normal pointing from p to c
+func (p PolygonOf[T]) IntersectsCircle(c CircleOf[T]) (normal VectorOf[T], depth T) {
+	normal, depth = c.IntersectsPolygon(p)
+	return normal.Invert(), depth
+}
+
+func (p PolygonOf[T]) ContainsPolygon(q PolygonOf[T]) (normal VectorOf[T], depth T) {
+	unset := T(math.Inf(1))
+	xDepth := unset
+	xNormal := VectorOf[T]{}
+	yDepth := unset
+	yNormal := VectorOf[T]{}
 	contained := true
 
 	for _, v := range q.Edges {
@@ -156,14 +251,14 @@ func (p Polygon) ContainsPolygon(q Polygon) (normal Vector, depth float64) {
 	}
 
 	if contained == true {
-		return Vector{}, 0.0
+		return VectorOf[T]{}, 0.0
 	}
 
 	for _, plane := range p.Planes {
 		minP, maxP := p.projectVectors(plane.Normal)
 		minQ, maxQ := q.projectVectors(plane.Normal)
 
-		planeDistance := maxQ - minQ - math.Min(maxQ-minP, maxP-minQ)
+		planeDistance := maxQ - minQ - min(maxQ-minP, maxP-minQ)
 		if planeDistance < xDepth && planeDistance > 0 && plane.Normal.X != 0 {
 			xDepth = planeDistance
 			xNormal = plane.Normal
@@ -179,7 +274,7 @@ func (p Polygon) ContainsPolygon(q Polygon) (normal Vector, depth float64) {
 		minP, maxP := p.projectVectors(plane.Normal)
 		minQ, maxQ := q.projectVectors(plane.Normal)
 
-		planeDistance := maxQ - minQ - math.Min(maxQ-minP, maxP-minQ)
+		planeDistance := maxQ - minQ - min(maxQ-minP, maxP-minQ)
 		if planeDistance < xDepth && planeDistance > 0 && plane.Normal.X != 0 {
 			xDepth = planeDistance
 			xNormal = plane.Normal
@@ -199,50 +294,59 @@ func (p Polygon) ContainsPolygon(q Polygon) (normal Vector, depth float64) {
 		yNormal = yNormal.Invert()
 	}
 
-	if xDepth == math.MaxFloat64 {
+	if xDepth == unset {
 		normal = yNormal
 		depth = yDepth
-	} else if yDepth == math.MaxFloat64 {
+	} else if yDepth == unset {
 		normal = xNormal
 		depth = xDepth
 	} else {
 		normal = xNormal.Add(yNormal).Normalize()
-		depth = math.Sqrt(math.Pow(xDepth, 2) + math.Pow(yDepth, 2))
+		depth = T(math.Sqrt(float64(xDepth*xDepth + yDepth*yDepth)))
 	}
 
 	return normal, depth
 }
 
-func (p Polygon) projectVectors(axis Vector) (min, max float64) {
-	min = math.MaxFloat64
-	max = -math.MaxFloat64
+// CastRay returns the closest hit against the active edges
+func (p PolygonOf[T]) CastRay(ray Ray) RayHit {
+	return castRayEdges(p.Edges, ray)
+}
 
-	for _, edge := range p.Edges {
-		projection := edge.Start.DotProduct(axis)
+// Support returns the vertex furthest along direction
+func (p PolygonOf[T]) Support(direction VectorOf[T]) VectorOf[T] {
+	return supportEdges(p.Edges, direction)
+}
 
-		if projection < min {
-			min = projection
-		}
-		if projection > max {
-			max = projection
-		}
-	}
+// Manifold returns the contact points between p and q, the normal points
+// from p to q
+func (p PolygonOf[T]) Manifold(q PolygonOf[T]) Manifold {
+	normal, depth := p.Intersects(q)
+	return edgeManifold(
+		ConvertEdges[float64](p.Edges),
+		ConvertEdges[float64](q.Edges),
+		ConvertVector[float64](normal),
+		float64(depth),
+	)
+}
 
-	return min, max
+func (p PolygonOf[T]) projectVectors(axis VectorOf[T]) (min, max T) {
+	return projectEdges(p.Edges, axis)
 }
 
-func (p Polygon) calcEdges() []Edge {
+func (p PolygonOf[T]) calcEdges() []EdgeOf[T] {
+	rotation := NewTransform(0, 0, 1, float64(p.Rotation))
 	for i := 0; i < len(p.rawEdges); i++ {
-		p.Edges[i].Start = p.Position.Add(p.rawEdges[i].Start)
-		p.Edges[i].End = p.Position.Add(p.rawEdges[i].End)
+		p.Edges[i].Start = p.Position.Add(p.rawEdges[i].Start.Transform(rotation))
+		p.Edges[i].End = p.Position.Add(p.rawEdges[i].End.Transform(rotation))
 		p.Edges[i].Active = p.rawEdges[i].Active
 	}
 
 	return p.Edges
 }
 
-func (p Polygon) calcPlanes() []Plane {
-	planes := make([]Plane, len(p.Edges))
+func (p PolygonOf[T]) calcPlanes() []PlaneOf[T] {
+	planes := make([]PlaneOf[T], len(p.Edges))
 	for i := 0; i < len(planes); i++ {
 		if p.Edges[i].Active {
 			planes[i] = NewPlane(p.Edges[i].Start, p.Edges[i].End)
@@ -252,51 +356,34 @@ func (p Polygon) calcPlanes() []Plane {
 	return planes
 }
 
-func (p Polygon) calcBounds() Rectangle {
-	minHeight, maxHeight := math.MaxFloat64, 0.0
-	minWidth, maxWidth := math.MaxFloat64, 0.0
-
-	for i := 0; i < len(p.Edges); i++ {
-		minWidth = min(minWidth, p.Edges[i].Start.X)
-		maxWidth = max(maxWidth, p.Edges[i].Start.X)
-
-		minHeight = min(minHeight, p.Edges[i].Start.Y)
-		maxHeight = max(maxHeight, p.Edges[i].Start.Y)
-	}
-
-	return NewRectangle(p.Position, maxWidth-minWidth, maxHeight-minHeight)
+func (p PolygonOf[T]) calcBounds() RectangleOf[T] {
+	return edgeBounds(p.Edges)
 }
 
-// Gauss's shoelace formula
-func (p Polygon) Area() float64 {
-	area := 0.0
-
-	for i := 0; i < len(p.Edges); i++ {
-		area += p.Edges[i].Start.X*p.Edges[i].End.Y - p.Edges[i].Start.Y*p.Edges[i].End.X
-	}
-
-	return math.Abs(area)
+// Area is the unsigned area from Gauss's shoelace formula
+func (p PolygonOf[T]) Area() T {
+	return T(math.Abs(float64(p.SignedArea())))
 }
 
-func (p Polygon) Clip(clip Polygon) Polygon {
+func (p PolygonOf[T]) Clip(clip PolygonOf[T]) PolygonOf[T] {
 	subject := p.Clone()
 	for i := 0; i < len(clip.Edges); i++ {
-		vectors := make([]Vector, 0, len(p.Edges))
+		vectors := make([]VectorOf[T], 0, len(p.Edges))
 		for j := 0; j < len(subject.Edges); j++ {
 			start := clip.Edges[i].ContainsVector(subject.Edges[j].Start)
 			end := clip.Edges[i].ContainsVector(subject.Edges[j].End)
 
 			switch {
 			case start && end:
-				vectors = append(vectors, NewVector(subject.Edges[j].End.X, subject.Edges[j].End.Y))
+				vectors = append(vectors, subject.Edges[j].End)
 			case !start && end:
-				vectors = append(vectors, NewVector(
+				vectors = append(vectors, NewVectorOf(
 					subject.Edges[j].XIntersect(clip.Edges[i]),
 					subject.Edges[j].YIntersect(clip.Edges[i]),
 				))
-				vectors = append(vectors, NewVector(subject.Edges[j].End.X, subject.Edges[j].End.Y))
+				vectors = append(vectors, subject.Edges[j].End)
 			case start && !end:
-				vectors = append(vectors, NewVector(
+				vectors = append(vectors, NewVectorOf(
 					subject.Edges[j].XIntersect(clip.Edges[i]),
 					subject.Edges[j].YIntersect(clip.Edges[i]),
 				))
@@ -304,12 +391,12 @@ func (p Polygon) Clip(clip Polygon) Polygon {
 			}
 		}
 
-		rawVectors := make([]Vector, len(vectors))
+		rawVectors := make([]VectorOf[T], len(vectors))
 		for k := 0; k < len(vectors); k++ {
-			rawVectors[k] = p.Position.Subtract(vectors[k])
+			rawVectors[k] = p.toLocal(vectors[k])
 		}
 
-		subject = NewPolygon(p.Position, rawVectors)
+		subject = p.reshape(rawVectors)
 	}
 
 	return subject
@@ -1,6 +1,7 @@
 package mosaic_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/maladroitthief/mosaic"
@@ -293,3 +294,215 @@ func BenchmarkPolygonClip(b *testing.B) {
 		subject.Clip(clip)
 	}
 }
+
+func Test_polygon_Transform(t *testing.T) {
+	type input struct {
+		polygon   mosaic.Polygon
+		transform mosaic.Transform
+	}
+	type want struct {
+		position mosaic.Vector
+		rotation float64
+		corner   mosaic.Vector
+		bounds   mosaic.Vector
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "translate",
+			input: input{polygon: square(0, 0, 2), transform: mosaic.NewTransform(1, 2, 1, 0)},
+			want: want{
+				position: mosaic.NewVector(1, 2),
+				corner:   mosaic.NewVector(0, 1),
+				bounds:   mosaic.NewVector(2, 2),
+			},
+		},
+		{
+			name:  "rotate and scale about the position",
+			input: input{polygon: square(0, 0, 2), transform: mosaic.NewTransform(1, 0, 2, 90)},
+			want: want{
+				position: mosaic.NewVector(1, 0),
+				rotation: 90,
+				corner:   mosaic.NewVector(3, -2),
+				bounds:   mosaic.NewVector(4, 4),
+			},
+		},
+		{
+			name:  "rotate a rectangle",
+			input: input{polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 2).ToPolygon(), transform: mosaic.NewTransform(0, 0, 1, 90)},
+			want: want{
+				position: mosaic.NewVector(0, 0),
+				rotation: 90,
+				corner:   mosaic.NewVector(1, -2),
+				bounds:   mosaic.NewVector(2, 4),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.polygon.Transform(tt.input.transform)
+
+			if got.Position != tt.want.position {
+				t.Errorf("polygon.Transform() position = %v, want %v", got.Position, tt.want.position)
+			}
+
+			if math.Abs(got.Rotation-tt.want.rotation) > 0.0001 {
+				t.Errorf("polygon.Transform() rotation = %v, want %v", got.Rotation, tt.want.rotation)
+			}
+
+			if !vectorWithinTolerance(got.Edges[0].Start, tt.want.corner, 0.0001) {
+				t.Errorf("polygon.Transform() corner = %v, want %v", got.Edges[0].Start, tt.want.corner)
+			}
+
+			bounds := mosaic.NewVector(got.Bounds().Width(), got.Bounds().Height())
+			if !vectorWithinTolerance(bounds, tt.want.bounds, 0.0001) {
+				t.Errorf("polygon.Transform() bounds = %v, want %v", bounds, tt.want.bounds)
+			}
+
+			if math.Abs(got.Planes[0].Normal.Length()-1) > 0.0001 {
+				t.Errorf("polygon.Transform() plane normal = %v, want a unit normal", got.Planes[0].Normal)
+			}
+		})
+	}
+}
+
+func Test_polygon_Rotate(t *testing.T) {
+	p := square(3, 4, 2)
+
+	got := p
+	for i := 0; i < 360; i++ {
+		got = got.Rotate(1)
+	}
+
+	if math.Abs(got.Rotation) > 0.0001 {
+		t.Errorf("polygon.Rotate() rotation = %v, want %v", got.Rotation, 0)
+	}
+
+	for i := range p.Edges {
+		if !vectorWithinTolerance(got.Edges[i].Start, p.Edges[i].Start, 1e-9) {
+			t.Errorf("polygon.Rotate() vertex %d = %v, want %v", i, got.Edges[i].Start, p.Edges[i].Start)
+		}
+	}
+
+	// Nothing accumulates in the raw vertices
+	reset := got.SetRotation(0)
+	for i := range p.Edges {
+		if reset.Edges[i].Start != p.Edges[i].Start {
+			t.Errorf("polygon.SetRotation() vertex %d = %v, want %v", i, reset.Edges[i].Start, p.Edges[i].Start)
+		}
+	}
+
+	quarter := p.Rotate(45)
+	if p.Rotation != 0 || p.Edges[0].Start != mosaic.NewVector(2, 3) {
+		t.Errorf("polygon.Rotate() changed the original polygon")
+	}
+
+	clone := quarter.Clone()
+	if clone.Rotation != 45 || !vectorWithinTolerance(clone.Edges[0].Start, quarter.Edges[0].Start, 1e-9) {
+		t.Errorf("polygon.Clone() got = %v, want %v", clone.Edges[0].Start, quarter.Edges[0].Start)
+	}
+
+	area := 0.0
+	for _, triangle := range quarter.Triangulate() {
+		area += triangle.Area()
+		if !quarter.ContainsVector(triangle.Centroid()) {
+			t.Errorf("polygon.Triangulate() triangle outside the rotated polygon at %v", triangle.Centroid())
+		}
+	}
+	if !WithinTolerance(area, 4, 0.0001) {
+		t.Errorf("polygon.Triangulate() area = %v, want %v", area, 4)
+	}
+}
+
+func Test_polygonOf_float32(t *testing.T) {
+	l := mosaic.NewPolygon(
+		mosaic.NewVectorOf[float32](1, 1),
+		[]mosaic.VectorOf[float32]{
+			mosaic.NewVectorOf[float32](0, 0),
+			mosaic.NewVectorOf[float32](4, 0),
+			mosaic.NewVectorOf[float32](4, 1),
+			mosaic.NewVectorOf[float32](1, 1),
+			mosaic.NewVectorOf[float32](1, 4),
+			mosaic.NewVectorOf[float32](0, 4),
+		},
+	)
+	type want struct {
+		area      float32
+		pieces    int
+		triangles int
+	}
+	tests := []struct {
+		name    string
+		polygon mosaic.PolygonOf[float32]
+		other   mosaic.PolygonOf[float32]
+		want    want
+	}{
+		{
+			name:    "concave",
+			polygon: l,
+			other:   mosaic.NewRectangle(mosaic.NewVectorOf[float32](1.5, 1.5), 1, 1).ToPolygon(),
+			want: want{
+				area:      7,
+				pieces:    2,
+				triangles: 4,
+			},
+		},
+		{
+			name:    "rotated",
+			polygon: l.Rotate(90),
+			other:   mosaic.NewRectangle(mosaic.NewVectorOf[float32](0.5, 1.5), 1, 1).ToPolygon(),
+			want: want{
+				area:      7,
+				pieces:    2,
+				triangles: 4,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.polygon.Area(); math.Abs(float64(got-tt.want.area)) > 0.0001 {
+				t.Errorf("polygon.Area() = %v, want %v", got, tt.want.area)
+			}
+
+			pieces := tt.polygon.Decompose()
+			if len(pieces) != tt.want.pieces {
+				t.Fatalf("polygon.Decompose() pieces = %v, want %v", len(pieces), tt.want.pieces)
+			}
+
+			area := float32(0)
+			for _, piece := range pieces {
+				area += piece.Area()
+			}
+			if math.Abs(float64(area-tt.want.area)) > 0.0001 {
+				t.Errorf("polygon.Decompose() area = %v, want %v", area, tt.want.area)
+			}
+
+			if got := len(tt.polygon.Triangulate()); got != tt.want.triangles {
+				t.Errorf("polygon.Triangulate() triangles = %v, want %v", got, tt.want.triangles)
+			}
+
+			wide := mosaic.ConvertPolygon[float64](tt.polygon)
+			if !WithinTolerance(wide.Area(), float64(tt.want.area), 0.0001) {
+				t.Errorf("mosaic.ConvertPolygon() area = %v, want %v", wide.Area(), tt.want.area)
+			}
+
+			other := mosaic.ConvertPolygon[float64](tt.other)
+			for i, piece := range wide.Decompose() {
+				_, want := piece.Intersects(other)
+				if _, got := pieces[i].Intersects(tt.other); math.Abs(float64(got)-want) > 0.0001 {
+					t.Errorf("polygon.Intersects() piece %d depth = %v, want %v", i, got, want)
+				}
+			}
+
+			back := mosaic.ConvertPolygon[float32](wide)
+			for i := range tt.polygon.Edges {
+				if back.Edges[i] != tt.polygon.Edges[i] {
+					t.Errorf("mosaic.ConvertPolygon() edge %d = %v, want %v", i, back.Edges[i], tt.polygon.Edges[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package mosaic
+
+import "math"
+
+type (
+	Ray struct {
+		Origin    Vector
+		Direction Vector
+		// Distance is how far the ray travels along Direction
+		Distance float64
+	}
+
+	RayHit struct {
+		Hit    bool
+		Point  Vector
+		Normal Vector
+		// Fraction of the ray's Distance travelled before the hit
+		Fraction float64
+	}
+)
+
+// NewRay normalizes direction
+func NewRay(origin, direction Vector, distance float64) Ray {
+	return Ray{
+		Origin:    origin,
+		Direction: direction.Normalize(),
+		Distance:  distance,
+	}
+}
+
+// NewSegmentRay casts from start to end
+func NewSegmentRay(start, end Vector) Ray {
+	return NewRay(start, end.Subtract(start), start.Distance(end))
+}
+
+func (r Ray) At(fraction float64) Vector {
+	return r.Origin.Add(r.Direction.Scale(fraction * r.Distance))
+}
+
+// CastRay dispatches the ray to the shape's CastRay
+func CastRay(s Shape, r Ray) RayHit {
+	switch shape := s.(type) {
+	case Circle:
+		return shape.CastRay(r)
+	case Rectangle:
+		return shape.CastRay(r)
+	case Triangle:
+		return shape.CastRay(r)
+	case Polygon:
+		return shape.CastRay(r)
+	case Compound:
+		return shape.CastRay(r)
+	case Region:
+		return shape.CastRay(r)
+	}
+
+	return RayHit{}
+}
+
+func castRayEdges[T Float](edges []EdgeOf[T], r Ray) RayHit {
+	closest := RayHit{Fraction: math.MaxFloat64}
+
+	for _, edge := range edges {
+		hit := edge.CastRay(r)
+		if hit.Hit && hit.Fraction < closest.Fraction {
+			closest = hit
+		}
+	}
+
+	if !closest.Hit {
+		return RayHit{}
+	}
+
+	return closest
+}
@@ -0,0 +1,146 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_CastRay(t *testing.T) {
+	type input struct {
+		shape mosaic.Shape
+		ray   mosaic.Ray
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  mosaic.RayHit
+	}{
+		{
+			name: "circle",
+			input: input{
+				shape: mosaic.NewCircle(mosaic.NewVector(10, 0), 2),
+				ray:   mosaic.NewRay(mosaic.NewVector(0, 0), mosaic.NewVector(1, 0), 20),
+			},
+			want: mosaic.RayHit{
+				Hit:      true,
+				Point:    mosaic.NewVector(8, 0),
+				Normal:   mosaic.NewVector(-1, 0),
+				Fraction: 0.4,
+			},
+		},
+		{
+			name: "circle out of range",
+			input: input{
+				shape: mosaic.NewCircle(mosaic.NewVector(10, 0), 2),
+				ray:   mosaic.NewRay(mosaic.NewVector(0, 0), mosaic.NewVector(1, 0), 5),
+			},
+			want: mosaic.RayHit{},
+		},
+		{
+			name: "rectangle",
+			input: input{
+				shape: mosaic.NewRectangle(mosaic.NewVector(0, 10), 4, 4),
+				ray:   mosaic.NewSegmentRay(mosaic.NewVector(1, 0), mosaic.NewVector(1, 20)),
+			},
+			want: mosaic.RayHit{
+				Hit:      true,
+				Point:    mosaic.NewVector(1, 8),
+				Normal:   mosaic.NewVector(0, -1),
+				Fraction: 0.4,
+			},
+		},
+		{
+			name: "polygon",
+			input: input{
+				shape: mosaic.NewPolygon(
+					mosaic.NewVector(0, 0),
+					[]mosaic.Vector{
+						mosaic.NewVector(5, -5),
+						mosaic.NewVector(10, 0),
+						mosaic.NewVector(5, 5),
+					},
+				),
+				ray: mosaic.NewRay(mosaic.NewVector(0, 0), mosaic.NewVector(1, 0), 10),
+			},
+			want: mosaic.RayHit{
+				Hit:      true,
+				Point:    mosaic.NewVector(5, 0),
+				Normal:   mosaic.NewVector(-1, 0),
+				Fraction: 0.5,
+			},
+		},
+		{
+			name: "polygon with inactive edge",
+			input: input{
+				shape: mosaic.NewPolygon(
+					mosaic.NewVector(0, 0),
+					[]mosaic.Vector{
+						mosaic.NewVector(5, -5),
+						mosaic.NewVector(10, 0),
+						mosaic.NewVector(5, 5),
+					},
+				).SetEdge(mosaic.NewVector(5, 5), mosaic.NewVector(5, -5), false),
+				ray: mosaic.NewRay(mosaic.NewVector(0, 1), mosaic.NewVector(1, 0), 10),
+			},
+			want: mosaic.RayHit{
+				Hit:      true,
+				Point:    mosaic.NewVector(9, 1),
+				Normal:   mosaic.NewVector(-math.Sqrt2/2, -math.Sqrt2/2),
+				Fraction: 0.9,
+			},
+		},
+		{
+			name: "region from outside",
+			input: input{
+				shape: mosaic.NewRegion(square(0, 0, 8), square(0, 0, 4)),
+				ray:   mosaic.NewRay(mosaic.NewVector(-10, 0), mosaic.NewVector(1, 0), 20),
+			},
+			want: mosaic.RayHit{
+				Hit:      true,
+				Point:    mosaic.NewVector(-4, 0),
+				Normal:   mosaic.NewVector(-1, 0),
+				Fraction: 0.3,
+			},
+		},
+		{
+			name: "region from the hole",
+			input: input{
+				shape: mosaic.NewRegion(square(0, 0, 8), square(0, 0, 4)),
+				ray:   mosaic.NewRay(mosaic.NewVector(0, 0), mosaic.NewVector(1, 0), 10),
+			},
+			want: mosaic.RayHit{
+				Hit:      true,
+				Point:    mosaic.NewVector(2, 0),
+				Normal:   mosaic.NewVector(-1, 0),
+				Fraction: 0.2,
+			},
+		},
+	}
+	tolerance := 1e-9
+	near := func(v, w mosaic.Vector) bool {
+		return math.Abs(v.X-w.X) < tolerance && math.Abs(v.Y-w.Y) < tolerance
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.CastRay(tt.input.shape, tt.input.ray)
+
+			if got.Hit != tt.want.Hit {
+				t.Fatalf("mosaic.CastRay() hit = %v, want %v", got.Hit, tt.want.Hit)
+			}
+
+			if !near(got.Point, tt.want.Point) {
+				t.Errorf("mosaic.CastRay() point = %v, want %v", got.Point, tt.want.Point)
+			}
+
+			if !near(got.Normal, tt.want.Normal) {
+				t.Errorf("mosaic.CastRay() normal = %v, want %v", got.Normal, tt.want.Normal)
+			}
+
+			if math.Abs(got.Fraction-tt.want.Fraction) > tolerance {
+				t.Errorf("mosaic.CastRay() fraction = %v, want %v", got.Fraction, tt.want.Fraction)
+			}
+		})
+	}
+}
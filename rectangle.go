@@ -2,26 +2,40 @@ package mosaic
 
 import "math"
 
-type Rectangle struct {
-	Position Vector
-	rawEdges [4]Edge
-	Edges    [4]Edge
-	Planes   [4]Plane
-}
+type (
+	RectangleOf[T Float] struct {
+		Position VectorOf[T]
+		rawEdges [4]EdgeOf[T]
+		Edges    [4]EdgeOf[T]
+		Planes   [4]PlaneOf[T]
+	}
+
+	Rectangle = RectangleOf[float64]
+)
 
-func NewRectangle(position Vector, w, h float64) Rectangle {
-	return Rectangle{
+func NewRectangle[T Float](position VectorOf[T], w, h T) RectangleOf[T] {
+	return RectangleOf[T]{
 		Position: position,
-		rawEdges: [4]Edge{
-			{Start: Vector{X: -w / 2, Y: -h / 2}, End: Vector{X: -w / 2, Y: h / 2}, Active: true},
-			{Start: Vector{X: -w / 2, Y: h / 2}, End: Vector{X: w / 2, Y: h / 2}, Active: true},
-			{Start: Vector{X: w / 2, Y: h / 2}, End: Vector{X: w / 2, Y: -h / 2}, Active: true},
-			{Start: Vector{X: w / 2, Y: -h / 2}, End: Vector{X: -w / 2, Y: -h / 2}, Active: true},
+		rawEdges: [4]EdgeOf[T]{
+			{Start: VectorOf[T]{X: -w / 2, Y: -h / 2}, End: VectorOf[T]{X: -w / 2, Y: h / 2}, Active: true},
+			{Start: VectorOf[T]{X: -w / 2, Y: h / 2}, End: VectorOf[T]{X: w / 2, Y: h / 2}, Active: true},
+			{Start: VectorOf[T]{X: w / 2, Y: h / 2}, End: VectorOf[T]{X: w / 2, Y: -h / 2}, Active: true},
+			{Start: VectorOf[T]{X: w / 2, Y: -h / 2}, End: VectorOf[T]{X: -w / 2, Y: -h / 2}, Active: true},
 		},
 	}.Update()
 }
 
-func (r Rectangle) Update() Rectangle {
+// ConvertRectangle changes the precision of r
+func ConvertRectangle[U, T Float](r RectangleOf[T]) RectangleOf[U] {
+	s := RectangleOf[U]{Position: ConvertVector[U](r.Position)}
+	for i := range r.rawEdges {
+		s.rawEdges[i] = ConvertEdge[U](r.rawEdges[i])
+	}
+
+	return s.Update()
+}
+
+func (r RectangleOf[T]) Update() RectangleOf[T] {
 	for i := 0; i < 4; i++ {
 		r.Edges[i].Start = r.Position.Add(r.rawEdges[i].Start)
 		r.Edges[i].End = r.Position.Add(r.rawEdges[i].End)
@@ -35,15 +49,24 @@ func (r Rectangle) Update() Rectangle {
 	return r
 }
 
-func (r Rectangle) Height() float64 {
+func (r RectangleOf[T]) Type() ShapeType {
+	return RectangleShape
+}
+
+// Bounds is the axis aligned rectangle enclosing r
+func (r RectangleOf[T]) Bounds() RectangleOf[T] {
+	return edgeBounds(r.Edges[:])
+}
+
+func (r RectangleOf[T]) Height() T {
 	return r.Edges[0].Start.Distance(r.Edges[0].End)
 }
 
-func (r Rectangle) Width() float64 {
+func (r RectangleOf[T]) Width() T {
 	return r.Edges[1].Start.Distance(r.Edges[1].End)
 }
 
-func (r Rectangle) MinPoint() Vector {
+func (r RectangleOf[T]) MinPoint() VectorOf[T] {
 	minPoint := r.Edges[0].Start
 	for i := 1; i < 4; i++ {
 		if minPoint.Length() > r.Edges[i].Start.Length() {
@@ -54,7 +77,7 @@ func (r Rectangle) MinPoint() Vector {
 	return minPoint
 }
 
-func (r Rectangle) MaxPoint() Vector {
+func (r RectangleOf[T]) MaxPoint() VectorOf[T] {
 	maxPoint := r.Edges[0].Start
 	for i := 1; i < 4; i++ {
 		if maxPoint.Length() < r.Edges[i].Start.Length() {
@@ -65,7 +88,7 @@ func (r Rectangle) MaxPoint() Vector {
 	return maxPoint
 }
 
-func (r Rectangle) Transform(t Transform) Rectangle {
+func (r RectangleOf[T]) Transform(t Transform) RectangleOf[T] {
 	positionTransform := Transform{
 		x:     t.x,
 		y:     t.y,
@@ -90,8 +113,8 @@ func (r Rectangle) Transform(t Transform) Rectangle {
 	return r.Update()
 }
 
-func (r Rectangle) Scale(c float64) Rectangle {
-	transform := NewTransform(0, 0, c, 0)
+func (r RectangleOf[T]) Scale(c T) RectangleOf[T] {
+	transform := NewTransform(0, 0, float64(c), 0)
 	for i := 0; i < 4; i++ {
 		r.rawEdges[i] = r.rawEdges[i].Transform(transform)
 	}
@@ -99,7 +122,7 @@ func (r Rectangle) Scale(c float64) Rectangle {
 	return r.Update()
 }
 
-func (r Rectangle) ContainsVector(v Vector) bool {
+func (r RectangleOf[T]) ContainsVector(v VectorOf[T]) bool {
 	rayCount := 0
 	for i := 0; i < len(r.Edges); i++ {
 		rayCount += r.Edges[i].RayCount(v)
@@ -108,18 +131,18 @@ func (r Rectangle) ContainsVector(v Vector) bool {
 	return rayCount%2 == 1
 }
 
-func (r Rectangle) Intersects(s Rectangle) (normal Vector, depth float64) {
-	depth = math.MaxFloat64
+func (r RectangleOf[T]) Intersects(s RectangleOf[T]) (normal VectorOf[T], depth T) {
+	depth = T(math.Inf(1))
 
 	for _, plane := range r.Planes {
 		minP, maxP := r.projectVectors(plane.Normal)
 		minQ, maxQ := s.projectVectors(plane.Normal)
 
 		if minP >= maxQ || minQ >= maxP {
-			return Vector{}, 0.0
+			return VectorOf[T]{}, 0.0
 		}
 
-		planeDistance := math.Min(maxQ-minP, maxP-minQ)
+		planeDistance := min(maxQ-minP, maxP-minQ)
 		if planeDistance < depth {
 			depth = planeDistance
 			normal = plane.Normal
@@ -131,10 +154,10 @@ func (r Rectangle) Intersects(s Rectangle) (normal Vector, depth float64) {
 		minQ, maxQ := s.projectVectors(plane.Normal)
 
 		if minP >= maxQ || minQ >= maxP {
-			return Vector{}, 0.0
+			return VectorOf[T]{}, 0.0
 		}
 
-		planeDistance := math.Min(maxQ-minP, maxP-minQ)
+		planeDistance := min(maxQ-minP, maxP-minQ)
 		if planeDistance < depth {
 			depth = planeDistance
 			normal = plane.Normal
@@ -148,28 +171,42 @@ func (r Rectangle) Intersects(s Rectangle) (normal Vector, depth float64) {
 	return normal, depth
 }
 
-func (r Rectangle) projectVectors(axis Vector) (min, max float64) {
-	min = math.MaxFloat64
-	max = -math.MaxFloat64
+// IntersectsCircle returns a normal pointing from r to c
+func (r RectangleOf[T]) IntersectsCircle(c CircleOf[T]) (normal VectorOf[T], depth T) {
+	normal, depth = c.IntersectsRectangle(r)
+	return normal.Invert(), depth
+}
 
-	for _, edge := range r.Edges {
-		projection := edge.Start.DotProduct(axis)
+// CastRay returns the closest hit against the active edges
+func (r RectangleOf[T]) CastRay(ray Ray) RayHit {
+	return castRayEdges(r.Edges[:], ray)
+}
 
-		if projection < min {
-			min = projection
-		}
-		if projection > max {
-			max = projection
-		}
-	}
+// Support returns the vertex furthest along direction
+func (r RectangleOf[T]) Support(direction VectorOf[T]) VectorOf[T] {
+	return supportEdges(r.Edges[:], direction)
+}
+
+// Manifold returns the contact points between r and s, the normal points
+// from r to s
+func (r RectangleOf[T]) Manifold(s RectangleOf[T]) Manifold {
+	normal, depth := r.Intersects(s)
+	return edgeManifold(
+		ConvertEdges[float64](r.Edges[:]),
+		ConvertEdges[float64](s.Edges[:]),
+		ConvertVector[float64](normal),
+		float64(depth),
+	)
+}
 
-	return min, max
+func (r RectangleOf[T]) projectVectors(axis VectorOf[T]) (min, max T) {
+	return projectEdges(r.Edges[:], axis)
 }
 
-func (r Rectangle) ToPolygon() Polygon {
+func (r RectangleOf[T]) ToPolygon() PolygonOf[T] {
 	return NewPolygon(
 		r.Position,
-		[]Vector{
+		[]VectorOf[T]{
 			r.rawEdges[0].Start,
 			r.rawEdges[1].Start,
 			r.rawEdges[2].Start,
@@ -177,12 +214,12 @@ func (r Rectangle) ToPolygon() Polygon {
 		})
 }
 
-func (r Rectangle) Area() float64 {
+func (r RectangleOf[T]) Area() T {
 	return r.Width() * r.Height()
 }
 
 // TODO - This does not support rotation
-func (r Rectangle) AreaOfOverlap(o Rectangle) float64 {
+func (r RectangleOf[T]) AreaOfOverlap(o RectangleOf[T]) T {
 	_, depth := r.Intersects(o)
 	if depth == 0.0 {
 		return 0.0
@@ -200,7 +237,7 @@ func (r Rectangle) AreaOfOverlap(o Rectangle) float64 {
 	return x * y
 }
 
-func (r Rectangle) ToCircle() Circle {
+func (r RectangleOf[T]) ToCircle() CircleOf[T] {
 	rMin := r.Position.Distance(r.MinPoint())
 	rMax := r.Position.Distance(r.MaxPoint())
 
@@ -0,0 +1,181 @@
+package mosaic
+
+import "math"
+
+type (
+	// RegionOf is an outer polygon with any number of holes cut out of it
+	RegionOf[T Float] struct {
+		Position VectorOf[T]
+		Outer    PolygonOf[T]
+		Holes    []PolygonOf[T]
+	}
+
+	Region = RegionOf[float64]
+)
+
+func NewRegion[T Float](outer PolygonOf[T], holes ...PolygonOf[T]) RegionOf[T] {
+	r := RegionOf[T]{
+		Position: outer.Position,
+		Outer:    outer,
+		Holes:    make([]PolygonOf[T], 0, len(holes)),
+	}
+
+	for _, hole := range holes {
+		if len(hole.Edges) < 3 {
+			continue
+		}
+
+		r.Holes = append(r.Holes, hole)
+	}
+
+	return r
+}
+
+// ConvertRegion changes the precision of r, a region that is already in U is
+// returned as is
+func ConvertRegion[U, T Float](r RegionOf[T]) RegionOf[U] {
+	if same, ok := any(r).(RegionOf[U]); ok {
+		return same
+	}
+
+	return RegionOf[U]{
+		Position: ConvertVector[U](r.Position),
+		Outer:    ConvertPolygon[U](r.Outer),
+		Holes:    ConvertPolygons[U](r.Holes),
+	}
+}
+
+// ConvertRegions changes the precision of every region into a new slice, a
+// slice that is already in U is returned as is
+func ConvertRegions[U, T Float](regions []RegionOf[T]) []RegionOf[U] {
+	if same, ok := any(regions).([]RegionOf[U]); ok {
+		return same
+	}
+
+	result := make([]RegionOf[U], len(regions))
+	for i, r := range regions {
+		result[i] = ConvertRegion[U](r)
+	}
+
+	return result
+}
+
+func (r RegionOf[T]) Type() ShapeType {
+	return RegionShape
+}
+
+func (r RegionOf[T]) Bounds() RectangleOf[T] {
+	return r.Outer.Bounds()
+}
+
+// SetPosition moves the outer polygon and every hole together
+func (r RegionOf[T]) SetPosition(position VectorOf[T]) RegionOf[T] {
+	if r.Position == position {
+		return r
+	}
+
+	offset := position.Subtract(r.Position)
+	holes := make([]PolygonOf[T], len(r.Holes))
+	for i, hole := range r.Holes {
+		holes[i] = hole.Clone().SetPosition(hole.Position.Add(offset))
+	}
+
+	r.Position = position
+	r.Outer = r.Outer.Clone().SetPosition(position)
+	r.Holes = holes
+
+	return r
+}
+
+func (r RegionOf[T]) ContainsVector(v VectorOf[T]) bool {
+	if !r.Outer.ContainsVector(v) {
+		return false
+	}
+
+	for _, hole := range r.Holes {
+		if hole.ContainsVector(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Area is the outer area less the area of every hole
+func (r RegionOf[T]) Area() T {
+	area := r.Outer.Area()
+	for _, hole := range r.Holes {
+		area -= hole.Area()
+	}
+
+	return area
+}
+
+// Clip clips the outer polygon and every hole against a convex polygon in
+// CCW rotation, holes that fall outside of clip are dropped
+func (r RegionOf[T]) Clip(clip PolygonOf[T]) RegionOf[T] {
+	holes := make([]PolygonOf[T], len(r.Holes))
+	for i, hole := range r.Holes {
+		holes[i] = hole.Clip(clip)
+	}
+
+	return NewRegion(r.Outer.Clip(clip), holes...)
+}
+
+func (r RegionOf[T]) Triangulate() []TriangleOf[T] {
+	return r.Outer.Triangulate(r.Holes...)
+}
+
+// Intersects returns the deepest collision between any triangle of r and s,
+// the normal points from r to s. It is worked out in float64 like Collide.
+func (r RegionOf[T]) Intersects(s Shape) (normal Vector, depth float64) {
+	for _, triangle := range ConvertRegion[float64](r).Triangulate() {
+		n, d := Collide(triangle, s)
+		if d > depth {
+			normal, depth = n, d
+		}
+	}
+
+	return normal, depth
+}
+
+// CastRay returns the closest hit against the outer polygon or any hole
+func (r RegionOf[T]) CastRay(ray Ray) RayHit {
+	closest := r.Outer.CastRay(ray)
+	if !closest.Hit {
+		closest.Fraction = math.MaxFloat64
+	}
+
+	for _, hole := range r.Holes {
+		hit := hole.CastRay(ray)
+		if hit.Hit && hit.Fraction < closest.Fraction {
+			closest = hit
+		}
+	}
+
+	if !closest.Hit {
+		return RayHit{}
+	}
+
+	return closest
+}
+
+// Sweep moves r by dr and s by ds and returns the earliest impact against any
+// triangle of r, the normal points from r to s. It is worked out in float64
+// like Sweep.
+func (r RegionOf[T]) Sweep(dr Vector, s Shape, ds Vector) Impact {
+	earliest := Impact{Time: math.MaxFloat64}
+
+	for _, triangle := range ConvertRegion[float64](r).Triangulate() {
+		impact := Sweep(triangle, dr, s, ds)
+		if impact.Hit && impact.Time < earliest.Time {
+			earliest = impact
+		}
+	}
+
+	if !earliest.Hit {
+		return Impact{}
+	}
+
+	return earliest
+}
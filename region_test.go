@@ -0,0 +1,135 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_region_ContainsVector(t *testing.T) {
+	donut := mosaic.NewRegion(square(0, 0, 10), square(0, 0, 4))
+	tests := []struct {
+		name   string
+		region mosaic.Region
+		vector mosaic.Vector
+		want   bool
+	}{
+		{
+			name:   "in the ring",
+			region: donut,
+			vector: mosaic.NewVector(3, 3),
+			want:   true,
+		},
+		{
+			name:   "in the hole",
+			region: donut,
+			vector: mosaic.NewVector(1, 1),
+			want:   false,
+		},
+		{
+			name:   "outside",
+			region: donut,
+			vector: mosaic.NewVector(6, 0),
+			want:   false,
+		},
+		{
+			name:   "moved into the hole",
+			region: donut.SetPosition(mosaic.NewVector(10, 10)),
+			vector: mosaic.NewVector(11, 11),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.region.ContainsVector(tt.vector)
+			if got != tt.want {
+				t.Errorf("region.ContainsVector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_region_Collide(t *testing.T) {
+	donut := mosaic.NewRegion(square(0, 0, 8), square(0, 0, 4))
+	tests := []struct {
+		name     string
+		shape    mosaic.Shape
+		overlaps bool
+	}{
+		{
+			name:     "circle in the ring",
+			shape:    mosaic.NewCircle(mosaic.NewVector(3, 0), 0.5),
+			overlaps: true,
+		},
+		{
+			name:     "circle in the hole",
+			shape:    mosaic.NewCircle(mosaic.NewVector(0, 0), 1),
+			overlaps: false,
+		},
+		{
+			name:     "rectangle across the hole edge",
+			shape:    mosaic.NewRectangle(mosaic.NewVector(2, 0), 1, 1),
+			overlaps: true,
+		},
+		{
+			name:     "rectangle outside",
+			shape:    mosaic.NewRectangle(mosaic.NewVector(10, 0), 2, 2),
+			overlaps: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, depth := mosaic.Collide(donut, tt.shape)
+			if got := depth > 0; got != tt.overlaps {
+				t.Errorf("mosaic.Collide() overlaps = %v, want %v", got, tt.overlaps)
+			}
+
+			_, depth = mosaic.Collide(tt.shape, donut)
+			if got := depth > 0; got != tt.overlaps {
+				t.Errorf("mosaic.Collide() reversed overlaps = %v, want %v", got, tt.overlaps)
+			}
+		})
+	}
+}
+
+func Test_region_Clip(t *testing.T) {
+	donut := mosaic.NewRegion(square(0, 0, 10), square(0, 0, 4))
+	tests := []struct {
+		name  string
+		clip  mosaic.Polygon
+		holes int
+		area  float64
+	}{
+		{
+			name:  "keeps the hole",
+			clip:  square(0, 0, 8),
+			holes: 1,
+			area:  64 - 16,
+		},
+		{
+			name:  "cuts the hole",
+			clip:  square(3, 0, 6),
+			holes: 1,
+			area:  30 - 8,
+		},
+		{
+			name:  "drops the hole",
+			clip:  square(4, 0, 2),
+			holes: 0,
+			area:  4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := donut.Clip(tt.clip)
+
+			if len(got.Holes) != tt.holes {
+				t.Errorf("region.Clip() holes = %v, want %v", len(got.Holes), tt.holes)
+			}
+
+			if !WithinTolerance(got.Area(), tt.area, 1e-9) {
+				t.Errorf("region.Clip() area = %v, want %v", got.Area(), tt.area)
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package mosaic
 
+import "math"
+
 type (
 	ShapeType int
 	Shape     interface {
 		Bounds() Rectangle
-		Type()
+		Type() ShapeType
 	}
 )
 
@@ -13,4 +15,135 @@ const (
 	TriangleShape
 	RectangleShape
 	PolygonShape
+	CompoundShape
+	RegionShape
 )
+
+// Collide runs the narrow phase for any pair of shapes. The normal points
+// from a towards b and a depth of zero means the shapes do not overlap.
+func Collide(a, b Shape) (normal Vector, depth float64) {
+	switch s := b.(type) {
+	case Compound:
+		normal, depth = s.Intersects(a)
+		return normal.Invert(), depth
+	case Region:
+		normal, depth = s.Intersects(a)
+		return normal.Invert(), depth
+	}
+
+	switch s := a.(type) {
+	case Compound:
+		return s.Intersects(b)
+	case Region:
+		return s.Intersects(b)
+	case Circle:
+		return collideCircle(s, b)
+	case Rectangle:
+		switch r := b.(type) {
+		case Rectangle:
+			return s.Intersects(r)
+		case Circle:
+			return s.IntersectsCircle(r)
+		}
+		return collidePolygon(s.ToPolygon(), b)
+	case Triangle:
+		switch u := b.(type) {
+		case Triangle:
+			return s.Intersects(u)
+		case Circle:
+			normal, depth = collideCircle(u, s)
+			return normal.Invert(), depth
+		}
+		return collidePolygon(s.ToPolygon(), b)
+	case Polygon:
+		return collidePolygon(s, b)
+	}
+
+	return Vector{}, 0.0
+}
+
+func collideCircle(c Circle, b Shape) (normal Vector, depth float64) {
+	switch s := b.(type) {
+	case Circle:
+		return c.Intersects(s)
+	case Rectangle:
+		return c.IntersectsRectangle(s)
+	case Triangle:
+		return circleIntersectsEdges(c, s.Edges[:], s.Planes[:])
+	case Polygon:
+		return c.IntersectsPolygon(s)
+	}
+
+	return Vector{}, 0.0
+}
+
+func collidePolygon(p Polygon, b Shape) (normal Vector, depth float64) {
+	switch s := b.(type) {
+	case Circle:
+		return p.IntersectsCircle(s)
+	case Rectangle:
+		return p.Intersects(s.ToPolygon())
+	case Triangle:
+		return p.Intersects(s.ToPolygon())
+	case Polygon:
+		return p.Intersects(s)
+	}
+
+	return Vector{}, 0.0
+}
+
+func projectEdges[T Float](edges []EdgeOf[T], axis VectorOf[T]) (min, max T) {
+	min = T(math.Inf(1))
+	max = T(math.Inf(-1))
+
+	for _, edge := range edges {
+		projection := edge.Start.DotProduct(axis)
+
+		if projection < min {
+			min = projection
+		}
+		if projection > max {
+			max = projection
+		}
+	}
+
+	return min, max
+}
+
+func supportEdges[T Float](edges []EdgeOf[T], direction VectorOf[T]) VectorOf[T] {
+	support := VectorOf[T]{}
+	best := T(math.Inf(-1))
+
+	for _, edge := range edges {
+		projection := edge.Start.DotProduct(direction)
+		if projection > best {
+			best = projection
+			support = edge.Start
+		}
+	}
+
+	return support
+}
+
+func edgeBounds[T Float](edges []EdgeOf[T]) RectangleOf[T] {
+	if len(edges) == 0 {
+		return RectangleOf[T]{}
+	}
+
+	minX, maxX := T(math.Inf(1)), T(math.Inf(-1))
+	minY, maxY := minX, maxX
+
+	for i := 0; i < len(edges); i++ {
+		minX = min(minX, edges[i].Start.X)
+		maxX = max(maxX, edges[i].Start.X)
+
+		minY = min(minY, edges[i].Start.Y)
+		maxY = max(maxY, edges[i].Start.Y)
+	}
+
+	return NewRectangle(
+		NewVectorOf((minX+maxX)/2, (minY+maxY)/2),
+		maxX-minX,
+		maxY-minY,
+	)
+}
@@ -0,0 +1,135 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_shape_Collide(t *testing.T) {
+	type input struct {
+		a mosaic.Shape
+		b mosaic.Shape
+	}
+	type want struct {
+		normal mosaic.Vector
+		depth  float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "circle circle",
+			input: input{
+				a: mosaic.NewCircle(mosaic.NewVector(0, 0), 2),
+				b: mosaic.NewCircle(mosaic.NewVector(3, 0), 2),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 1, Y: 0},
+				depth:  1,
+			},
+		},
+		{
+			name: "circle rectangle",
+			input: input{
+				a: mosaic.NewCircle(mosaic.NewVector(0, 0), 2),
+				b: mosaic.NewRectangle(mosaic.NewVector(5, 0), 8, 8),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 1, Y: 0},
+				depth:  1,
+			},
+		},
+		{
+			name: "polygon circle",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(5, 0), 8, 8).ToPolygon(),
+				b: mosaic.NewCircle(mosaic.NewVector(0, 0), 2),
+			},
+			want: want{
+				normal: mosaic.Vector{X: -1, Y: 0},
+				depth:  1,
+			},
+		},
+		{
+			name: "rectangle polygon",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+				b: mosaic.NewRectangle(mosaic.NewVector(0, 3), 4, 4).ToPolygon(),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 0, Y: 1},
+				depth:  1,
+			},
+		},
+		{
+			name: "rectangle rectangle apart",
+			input: input{
+				a: mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+				b: mosaic.NewRectangle(mosaic.NewVector(10, 10), 4, 4),
+			},
+			want: want{
+				normal: mosaic.Vector{},
+				depth:  0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := struct {
+				normal mosaic.Vector
+				depth  float64
+			}{}
+			got.normal, got.depth = mosaic.Collide(tt.input.a, tt.input.b)
+
+			if got.normal != tt.want.normal {
+				t.Errorf("mosaic.Collide() normal = %v, want %v", got.normal, tt.want.normal)
+			}
+
+			if got.depth != tt.want.depth {
+				t.Errorf("mosaic.Collide() depth = %v, want %v", got.depth, tt.want.depth)
+			}
+		})
+	}
+}
+
+func Test_shape_Bounds(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape mosaic.Shape
+		want  mosaic.Rectangle
+	}{
+		{
+			name:  "circle",
+			shape: mosaic.NewCircle(mosaic.NewVector(1, 2), 3),
+			want:  mosaic.NewRectangle(mosaic.NewVector(1, 2), 6, 6),
+		},
+		{
+			name:  "rectangle",
+			shape: mosaic.NewRectangle(mosaic.NewVector(1, 2), 4, 6),
+			want:  mosaic.NewRectangle(mosaic.NewVector(1, 2), 4, 6),
+		},
+		{
+			name: "off center polygon",
+			shape: mosaic.NewPolygon(
+				mosaic.NewVector(0, 0),
+				[]mosaic.Vector{
+					mosaic.NewVector(-2, -4),
+					mosaic.NewVector(6, -4),
+					mosaic.NewVector(6, 0),
+				},
+			),
+			want: mosaic.NewRectangle(mosaic.NewVector(2, -2), 8, 4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.shape.Bounds()
+			if got.Edges != tt.want.Edges {
+				t.Errorf("shape.Bounds() = %v, want %v", got.Edges, tt.want.Edges)
+			}
+		})
+	}
+}
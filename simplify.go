@@ -0,0 +1,265 @@
+package mosaic
+
+import "math"
+
+type SimplifyMethod int
+
+const (
+	RamerDouglasPeucker SimplifyMethod = iota
+	VisvalingamWhyatt
+)
+
+// Simplify removes vertices from p that are not needed to stay within
+// tolerance of the original outline. For RamerDouglasPeucker the tolerance is
+// the distance a removed vertex may lie from the simplified edge, for
+// VisvalingamWhyatt it is the area of the triangle a removed vertex formed
+// with its neighbours. When preserveSimple is set, vertices are kept wherever
+// removing them would make the ring cross itself. The ring keeps its winding,
+// its Position, its Rotation and at least three vertices.
+func (p PolygonOf[T]) Simplify(tolerance T, method SimplifyMethod, preserveSimple bool) PolygonOf[T] {
+	ring := make([]Vector, len(p.rawEdges))
+	for i, edge := range p.rawEdges {
+		ring[i] = ConvertVector[float64](edge.Start)
+	}
+
+	if len(ring) <= 3 {
+		return p.reshape(ConvertVectors[T](ring))
+	}
+
+	var keep []bool
+	switch method {
+	case VisvalingamWhyatt:
+		keep = visvalingamWhyatt(ring, float64(tolerance), preserveSimple)
+	default:
+		keep = ramerDouglasPeucker(ring, float64(tolerance), preserveSimple)
+	}
+
+	vectors := make([]Vector, 0, len(ring))
+	for i, v := range ring {
+		if keep[i] {
+			vectors = append(vectors, v)
+		}
+	}
+
+	return p.reshape(ConvertVectors[T](vectors))
+}
+
+// ramerDouglasPeucker splits the ring between its first vertex and the vertex
+// furthest from it, then simplifies both chains
+func ramerDouglasPeucker(ring []Vector, tolerance float64, preserveSimple bool) []bool {
+	n := len(ring)
+	keep := make([]bool, n)
+
+	far := 0
+	for i := range ring {
+		if ring[i].Distance(ring[0]) > ring[far].Distance(ring[0]) {
+			far = i
+		}
+	}
+
+	keep[0] = true
+	keep[far] = true
+	rdpSplit(ring, keep, 0, far, tolerance)
+	rdpSplit(ring, keep, far, n, tolerance)
+
+	// A ring within tolerance of a line still needs a third vertex
+	if countKept(keep) < 3 {
+		if i := furthestFrom(ring, 0, far); i >= 0 {
+			keep[i] = true
+		}
+		if i := furthestFrom(ring, far, n); i >= 0 && countKept(keep) < 3 {
+			keep[i] = true
+		}
+	}
+
+	if !preserveSimple {
+		return keep
+	}
+
+	// Put vertices back into crossing edges until the ring is simple again,
+	// this ends at the original ring at the latest
+	for {
+		kept := keptIndices(keep)
+		crossing := crossingEdges(ring, kept)
+		if len(crossing) == 0 {
+			return keep
+		}
+
+		restored := false
+		for _, e := range crossing {
+			i, j := kept[e], kept[(e+1)%len(kept)]
+			if j <= i {
+				j += n
+			}
+			if k := furthestFrom(ring, i, j); k >= 0 {
+				keep[k%n] = true
+				restored = true
+			}
+		}
+
+		if !restored {
+			return keep
+		}
+	}
+}
+
+// rdpSplit keeps the vertex between i and j furthest from the chord i, j when
+// it is outside tolerance and recurses on both halves. Indices past the end of
+// the ring wrap around.
+func rdpSplit(ring []Vector, keep []bool, i, j int, tolerance float64) {
+	k := furthestFrom(ring, i, j)
+	if k < 0 {
+		return
+	}
+
+	n := len(ring)
+	if segmentDistance(ring[k%n], ring[i%n], ring[j%n]) <= tolerance {
+		return
+	}
+
+	keep[k%n] = true
+	rdpSplit(ring, keep, i, k, tolerance)
+	rdpSplit(ring, keep, k, j, tolerance)
+}
+
+// furthestFrom finds the vertex strictly between i and j furthest from the
+// chord i, j, or -1 when there is none
+func furthestFrom(ring []Vector, i, j int) int {
+	n := len(ring)
+	best, distance := -1, -1.0
+	for k := i + 1; k < j; k++ {
+		d := segmentDistance(ring[k%n], ring[i%n], ring[j%n])
+		if d > distance {
+			best, distance = k, d
+		}
+	}
+
+	return best
+}
+
+// visvalingamWhyatt repeatedly drops the vertex that spans the smallest
+// triangle with its neighbours until every remaining triangle reaches the
+// tolerance
+func visvalingamWhyatt(ring []Vector, tolerance float64, preserveSimple bool) []bool {
+	n := len(ring)
+	keep := make([]bool, n)
+	for i := range keep {
+		keep[i] = true
+	}
+
+	for count := n; count > 3; count-- {
+		kept := keptIndices(keep)
+		m := len(kept)
+
+		best, area := -1, math.Inf(1)
+		for k := 0; k < m; k++ {
+			prev := ring[kept[(k-1+m)%m]]
+			next := ring[kept[(k+1)%m]]
+			a := math.Abs(turn(prev, ring[kept[k]], next)) / 2
+			if a >= tolerance || a >= area {
+				continue
+			}
+
+			if preserveSimple && shortcutCrosses(ring, kept, k) {
+				continue
+			}
+
+			best, area = k, a
+		}
+
+		if best < 0 {
+			break
+		}
+		keep[kept[best]] = false
+	}
+
+	return keep
+}
+
+// shortcutCrosses reports whether the edge that replaces kept vertex k would
+// touch any other edge of the ring
+func shortcutCrosses(ring []Vector, kept []int, k int) bool {
+	m := len(kept)
+	a := ring[kept[(k-1+m)%m]]
+	b := ring[kept[(k+1)%m]]
+
+	for e := 0; e < m; e++ {
+		// Skip the two edges being replaced and the edges on either side
+		if e == k || e == (k-1+m)%m || e == (k+1)%m || e == (k-2+m)%m {
+			continue
+		}
+
+		if segmentsTouch(a, b, ring[kept[e]], ring[kept[(e+1)%m]]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// crossingEdges lists the edges of the kept ring that touch an edge they are
+// not adjacent to, edge e runs from kept[e] to kept[e+1]
+func crossingEdges(ring []Vector, kept []int) []int {
+	m := len(kept)
+	crossing := []int{}
+	for e := 0; e < m; e++ {
+		a, b := ring[kept[e]], ring[kept[(e+1)%m]]
+		for f := 0; f < m; f++ {
+			if f == e || f == (e+1)%m || e == (f+1)%m {
+				continue
+			}
+
+			if segmentsTouch(a, b, ring[kept[f]], ring[kept[(f+1)%m]]) {
+				crossing = append(crossing, e)
+				break
+			}
+		}
+	}
+
+	return crossing
+}
+
+// segmentsTouch reports whether the segments a, b and c, d share any point
+func segmentsTouch[T Float](a, b, c, d VectorOf[T]) bool {
+	d1 := turn(a, b, c)
+	d2 := turn(a, b, d)
+	d3 := turn(c, d, a)
+	d4 := turn(c, d, b)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) &&
+		((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(a, b, c)) ||
+		(d2 == 0 && onSegment(a, b, d)) ||
+		(d3 == 0 && onSegment(c, d, a)) ||
+		(d4 == 0 && onSegment(c, d, b))
+}
+
+// onSegment reports whether v, known to be collinear with a and b, lies
+// between them
+func onSegment[T Float](a, b, v VectorOf[T]) bool {
+	return min(a.X, b.X) <= v.X && v.X <= max(a.X, b.X) &&
+		min(a.Y, b.Y) <= v.Y && v.Y <= max(a.Y, b.Y)
+}
+
+// segmentDistance is the distance from v to the closest point of a, b
+func segmentDistance(v, a, b Vector) float64 {
+	return v.Distance(closestSegmentPoint(v, a, b))
+}
+
+func keptIndices(keep []bool) []int {
+	kept := make([]int, 0, len(keep))
+	for i, k := range keep {
+		if k {
+			kept = append(kept, i)
+		}
+	}
+
+	return kept
+}
+
+func countKept(keep []bool) int {
+	return len(keptIndices(keep))
+}
@@ -0,0 +1,111 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_Simplify(t *testing.T) {
+	noisy := mosaic.NewPolygon(
+		mosaic.NewVector(1, 1),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(2, 0.05),
+			mosaic.NewVector(4, -0.05),
+			mosaic.NewVector(6, 0),
+			mosaic.NewVector(6.05, 3),
+			mosaic.NewVector(6, 6),
+			mosaic.NewVector(3, 6.1),
+			mosaic.NewVector(0, 6),
+			mosaic.NewVector(-0.1, 3),
+		},
+	)
+	// Dropping the bump at the top would cut through the crack below it
+	cracked := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(4, 0),
+			mosaic.NewVector(5, 10.1),
+			mosaic.NewVector(6, 0),
+			mosaic.NewVector(10, 0),
+			mosaic.NewVector(10, 10),
+			mosaic.NewVector(5, 10.3),
+			mosaic.NewVector(0, 10),
+		},
+	)
+	type input struct {
+		polygon        mosaic.Polygon
+		tolerance      float64
+		method         mosaic.SimplifyMethod
+		preserveSimple bool
+	}
+	type want struct {
+		vertices int
+		area     float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "ramer douglas peucker",
+			input: input{polygon: noisy, tolerance: 0.2, method: mosaic.RamerDouglasPeucker},
+			want:  want{vertices: 4, area: 36},
+		},
+		{
+			name:  "visvalingam whyatt",
+			input: input{polygon: noisy, tolerance: 0.5, method: mosaic.VisvalingamWhyatt},
+			want:  want{vertices: 4, area: 36},
+		},
+		{
+			name:  "tolerance too small",
+			input: input{polygon: noisy, tolerance: 0.01, method: mosaic.RamerDouglasPeucker},
+			want:  want{vertices: 9, area: noisy.Area()},
+		},
+		{
+			name:  "collapsed ring keeps three vertices",
+			input: input{polygon: noisy, tolerance: 100, method: mosaic.RamerDouglasPeucker},
+			want:  want{vertices: 3},
+		},
+		{
+			name:  "ramer douglas peucker crossing",
+			input: input{polygon: cracked, tolerance: 0.5, method: mosaic.RamerDouglasPeucker},
+			want:  want{vertices: 7},
+		},
+		{
+			name:  "ramer douglas peucker preserving simplicity",
+			input: input{polygon: cracked, tolerance: 0.5, method: mosaic.RamerDouglasPeucker, preserveSimple: true},
+			want:  want{vertices: 8},
+		},
+		{
+			name:  "visvalingam whyatt crossing",
+			input: input{polygon: cracked, tolerance: 2, method: mosaic.VisvalingamWhyatt},
+			want:  want{vertices: 7},
+		},
+		{
+			name:  "visvalingam whyatt preserving simplicity",
+			input: input{polygon: cracked, tolerance: 2, method: mosaic.VisvalingamWhyatt, preserveSimple: true},
+			want:  want{vertices: 8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.polygon.Simplify(tt.input.tolerance, tt.input.method, tt.input.preserveSimple)
+
+			if len(got.Edges) != tt.want.vertices {
+				t.Errorf("polygon.Simplify() vertices = %v, want %v", len(got.Edges), tt.want.vertices)
+			}
+
+			if got.Position != tt.input.polygon.Position {
+				t.Errorf("polygon.Simplify() position = %v, want %v", got.Position, tt.input.polygon.Position)
+			}
+
+			if tt.want.area != 0 && !WithinTolerance(got.Area(), tt.want.area, 0.0001) {
+				t.Errorf("polygon.Simplify() area = %v, want %v", got.Area(), tt.want.area)
+			}
+		})
+	}
+}
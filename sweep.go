@@ -0,0 +1,354 @@
+package mosaic
+
+import "math"
+
+type (
+	// Impact is the result of a swept test, Time is the fraction of the step
+	// in [0,1] at first contact and Normal points from the first shape to
+	// the second
+	Impact struct {
+		Hit    bool
+		Time   float64
+		Normal Vector
+	}
+)
+
+// Sweep moves a by da and b by db over a single step and reports the first
+// time the two shapes touch
+func Sweep(a Shape, da Vector, b Shape, db Vector) Impact {
+	switch s := b.(type) {
+	case Compound:
+		impact := s.Sweep(db, a, da)
+		impact.Normal = impact.Normal.Invert()
+		return impact
+	case Region:
+		impact := s.Sweep(db, a, da)
+		impact.Normal = impact.Normal.Invert()
+		return impact
+	}
+
+	switch s := a.(type) {
+	case Compound:
+		return s.Sweep(da, b, db)
+	case Region:
+		return s.Sweep(da, b, db)
+	case Circle:
+		return sweepCircle(s, da, b, db)
+	case Rectangle:
+		return sweepEdgeShape(s.Position, s.Edges[:], s.Planes[:], da, b, db)
+	case Triangle:
+		return sweepEdgeShape(s.Position, s.Edges[:], s.Planes[:], da, b, db)
+	case Polygon:
+		return sweepEdgeShape(s.Position, s.Edges, s.Planes, da, b, db)
+	}
+
+	return Impact{}
+}
+
+func sweepCircle(c Circle, dc Vector, b Shape, db Vector) Impact {
+	switch s := b.(type) {
+	case Circle:
+		return c.Sweep(dc, s, db)
+	case Rectangle:
+		return sweepCircleEdges(c, dc.Subtract(db), s.Edges[:], s.Planes[:])
+	case Triangle:
+		return sweepCircleEdges(c, dc.Subtract(db), s.Edges[:], s.Planes[:])
+	case Polygon:
+		return c.SweepPolygon(dc, s, db)
+	}
+
+	return Impact{}
+}
+
+func sweepEdgeShape(
+	position Vector,
+	edges []Edge,
+	planes []Plane,
+	velocity Vector,
+	b Shape,
+	db Vector,
+) Impact {
+	var impact Impact
+
+	switch s := b.(type) {
+	case Circle:
+		impact = sweepCircleEdges(s, db.Subtract(velocity), edges, planes)
+		impact.Normal = impact.Normal.Invert()
+	case Rectangle:
+		impact = sweepEdges(position, edges, planes, s.Position, s.Edges[:], s.Planes[:], db.Subtract(velocity))
+	case Triangle:
+		impact = sweepEdges(position, edges, planes, s.Position, s.Edges[:], s.Planes[:], db.Subtract(velocity))
+	case Polygon:
+		impact = sweepEdges(position, edges, planes, s.Position, s.Edges, s.Planes, db.Subtract(velocity))
+	}
+
+	return impact
+}
+
+// Sweep moves c by dc and d by dd and returns the first time they touch
+func (c CircleOf[T]) Sweep(dc VectorOf[T], d CircleOf[T], dd VectorOf[T]) Impact {
+	return sweepCircles(ConvertCircle[float64](c), ConvertVector[float64](dc), ConvertCircle[float64](d), ConvertVector[float64](dd))
+}
+
+func sweepCircles(c Circle, dc Vector, d Circle, dd Vector) Impact {
+	offset := d.Position.Subtract(c.Position)
+	velocity := dd.Subtract(dc)
+	radii := c.Radius + d.Radius
+
+	if offset.Magnitude() < radii {
+		normal, _ := c.Intersects(d)
+		return Impact{Hit: true, Time: 0, Normal: normal}
+	}
+
+	t, ok := sweepCircleTime(offset, velocity, radii)
+	if !ok {
+		return Impact{}
+	}
+
+	return Impact{
+		Hit:    true,
+		Time:   t,
+		Normal: offset.Add(velocity.Scale(t)).Normalize(),
+	}
+}
+
+// SweepPolygon moves c by dc and a convex p by dp, the normal points from c to p
+func (c CircleOf[T]) SweepPolygon(dc VectorOf[T], p PolygonOf[T], dp VectorOf[T]) Impact {
+	q := ConvertPolygon[float64](p)
+	return sweepCircleEdges(ConvertCircle[float64](c), ConvertVector[float64](dc.Subtract(dp)), q.Edges, q.Planes)
+}
+
+// SweepCircle moves a convex p by dp and c by dc, the normal points from p to c
+func (p PolygonOf[T]) SweepCircle(dp VectorOf[T], c CircleOf[T], dc VectorOf[T]) Impact {
+	impact := c.SweepPolygon(dc, p, dp)
+	impact.Normal = impact.Normal.Invert()
+	return impact
+}
+
+// Sweep moves the convex polygons p by dp and q by dq and returns the first
+// time they touch
+func (p PolygonOf[T]) Sweep(dp VectorOf[T], q PolygonOf[T], dq VectorOf[T]) Impact {
+	a, b := ConvertPolygon[float64](p), ConvertPolygon[float64](q)
+	return sweepEdges(a.Position, a.Edges, a.Planes, b.Position, b.Edges, b.Planes, ConvertVector[float64](dq.Subtract(dp)))
+}
+
+// Sweep moves r by dr and s by ds and returns the first time they touch
+func (r RectangleOf[T]) Sweep(dr VectorOf[T], s RectangleOf[T], ds VectorOf[T]) Impact {
+	a, b := ConvertRectangle[float64](r), ConvertRectangle[float64](s)
+	return sweepEdges(a.Position, a.Edges[:], a.Planes[:], b.Position, b.Edges[:], b.Planes[:], ConvertVector[float64](ds.Subtract(dr)))
+}
+
+// sweepEdges is SAT on the planes of both shapes with q moving by velocity
+// relative to p. Each plane gives the interval of time the projections
+// overlap and the shapes touch once every interval has been entered.
+func sweepEdges(
+	pPosition Vector,
+	p []Edge,
+	pPlanes []Plane,
+	qPosition Vector,
+	q []Edge,
+	qPlanes []Plane,
+	velocity Vector,
+) Impact {
+	enter := -math.MaxFloat64
+	exit := math.MaxFloat64
+	normal := Vector{}
+	separated := false
+
+	axes := func(edges []Edge, planes []Plane) bool {
+		for i, plane := range planes {
+			if !edges[i].Active {
+				continue
+			}
+
+			axis := plane.Normal
+			minP, maxP := projectEdges(p, axis)
+			minQ, maxQ := projectEdges(q, axis)
+			speed := velocity.DotProduct(axis)
+
+			var axisEnter, axisExit float64
+			axisNormal := axis
+			switch {
+			case maxQ <= minP:
+				if speed <= 0 {
+					return false
+				}
+				axisEnter = (minP - maxQ) / speed
+				axisExit = (maxP - minQ) / speed
+				axisNormal = axis.Invert()
+				separated = true
+			case maxP <= minQ:
+				if speed >= 0 {
+					return false
+				}
+				axisEnter = (maxP - minQ) / speed
+				axisExit = (minP - maxQ) / speed
+				separated = true
+			default:
+				axisEnter = -math.MaxFloat64
+				axisExit = math.MaxFloat64
+				if speed > 0 {
+					axisExit = (maxP - minQ) / speed
+				} else if speed < 0 {
+					axisExit = (minP - maxQ) / speed
+				}
+			}
+
+			if axisEnter > enter {
+				enter = axisEnter
+				normal = axisNormal
+			}
+			exit = min(exit, axisExit)
+
+			if enter > exit || enter > 1 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if !axes(p, pPlanes) || !axes(q, qPlanes) {
+		return Impact{}
+	}
+
+	if !separated {
+		normal, _ = satEdges(pPosition, p, pPlanes, qPosition, q, qPlanes)
+		return Impact{Hit: true, Time: 0, Normal: normal}
+	}
+
+	return Impact{Hit: true, Time: max(enter, 0), Normal: normal}
+}
+
+// satEdges mirrors Polygon.Intersects for shapes that are not polygons
+func satEdges(
+	pPosition Vector,
+	p []Edge,
+	pPlanes []Plane,
+	qPosition Vector,
+	q []Edge,
+	qPlanes []Plane,
+) (normal Vector, depth float64) {
+	depth = math.MaxFloat64
+
+	for _, planes := range [][]Plane{pPlanes, qPlanes} {
+		for _, plane := range planes {
+			minP, maxP := projectEdges(p, plane.Normal)
+			minQ, maxQ := projectEdges(q, plane.Normal)
+
+			if minP >= maxQ || minQ >= maxP {
+				return Vector{}, 0.0
+			}
+
+			planeDistance := math.Min(maxQ-minP, maxP-minQ)
+			if planeDistance < depth {
+				depth = planeDistance
+				normal = plane.Normal
+			}
+		}
+	}
+
+	if normal.DotProduct(qPosition.Subtract(pPosition)) < 0 {
+		normal = normal.Invert()
+	}
+
+	return normal, depth
+}
+
+// sweepCircleEdges casts the circle's center along velocity against the
+// edges inflated by the radius. The normal points from the circle to the edges.
+func sweepCircleEdges(
+	c Circle,
+	velocity Vector,
+	edges []Edge,
+	planes []Plane,
+) Impact {
+	normal, depth := circleIntersectsEdges(c, edges, planes)
+	if depth > 0 {
+		return Impact{Hit: true, Time: 0, Normal: normal}
+	}
+
+	winding := edgeWinding(edges)
+	impact := Impact{Time: math.MaxFloat64}
+
+	for _, edge := range edges {
+		if !edge.Active {
+			continue
+		}
+
+		// Outward facing normal of the edge
+		outward := edge.Start.RightNormal(edge.End)
+		if winding < 0 {
+			outward = outward.Invert()
+		}
+
+		speed := velocity.DotProduct(outward)
+		if speed >= 0 {
+			continue
+		}
+
+		t := (outward.DotProduct(edge.Start) + c.Radius - outward.DotProduct(c.Position)) / speed
+		if t < 0 || t > 1 || t >= impact.Time {
+			continue
+		}
+
+		center := c.Position.Add(velocity.Scale(t))
+		along := edge.End.Subtract(edge.Start)
+		s := center.Subtract(edge.Start).DotProduct(along)
+		if s < 0 || s > along.Length() {
+			continue
+		}
+
+		impact = Impact{Hit: true, Time: t, Normal: outward.Invert()}
+	}
+
+	for _, edge := range edges {
+		t, ok := sweepCircleTime(edge.Start.Subtract(c.Position), velocity.Invert(), c.Radius)
+		if !ok || t >= impact.Time {
+			continue
+		}
+
+		center := c.Position.Add(velocity.Scale(t))
+		impact = Impact{Hit: true, Time: t, Normal: edge.Start.Subtract(center).Normalize()}
+	}
+
+	if !impact.Hit {
+		return Impact{}
+	}
+
+	return impact
+}
+
+// sweepCircleTime finds the first t in [0,1] where |offset + velocity*t| is
+// radius, offset starting outside of radius
+func sweepCircleTime(offset, velocity Vector, radius float64) (float64, bool) {
+	a := velocity.DotProduct(velocity)
+	b := 2 * offset.DotProduct(velocity)
+	c := offset.DotProduct(offset) - radius*radius
+
+	if a == 0 {
+		return 0, false
+	}
+
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	t := (-b - math.Sqrt(discriminant)) / (2 * a)
+	if t < 0 || t > 1 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// edgeWinding is twice the signed area of the edges, positive for CCW
+func edgeWinding[T Float](edges []EdgeOf[T]) T {
+	area := T(0)
+	for _, edge := range edges {
+		area += edge.Start.CrossProduct(edge.End)
+	}
+
+	return area
+}
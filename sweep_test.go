@@ -0,0 +1,203 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_Sweep(t *testing.T) {
+	type input struct {
+		a  mosaic.Shape
+		da mosaic.Vector
+		b  mosaic.Shape
+		db mosaic.Vector
+	}
+	l := mosaic.NewPolygon(
+		mosaic.NewVector(0, 0),
+		[]mosaic.Vector{
+			mosaic.NewVector(0, 0),
+			mosaic.NewVector(4, 0),
+			mosaic.NewVector(4, 1),
+			mosaic.NewVector(1, 1),
+			mosaic.NewVector(1, 4),
+			mosaic.NewVector(0, 4),
+		},
+	)
+	tests := []struct {
+		name  string
+		input input
+		want  mosaic.Impact
+	}{
+		{
+			name: "bullet through a thin wall",
+			input: input{
+				a:  mosaic.NewRectangle(mosaic.NewVector(0, 0), 1, 1).ToPolygon(),
+				da: mosaic.NewVector(100, 0),
+				b:  mosaic.NewRectangle(mosaic.NewVector(50.5, 0), 0.1, 20).ToPolygon(),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.4995,
+				Normal: mosaic.NewVector(1, 0),
+			},
+		},
+		{
+			name: "both moving",
+			input: input{
+				a:  mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2),
+				da: mosaic.NewVector(0, 4),
+				b:  mosaic.NewRectangle(mosaic.NewVector(0, 10), 2, 2),
+				db: mosaic.NewVector(0, -4),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   1,
+				Normal: mosaic.NewVector(0, 1),
+			},
+		},
+		{
+			name: "passing by",
+			input: input{
+				a:  mosaic.NewRectangle(mosaic.NewVector(0, 0), 2, 2).ToPolygon(),
+				da: mosaic.NewVector(10, 0),
+				b:  mosaic.NewRectangle(mosaic.NewVector(5, 5), 2, 2).ToPolygon(),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{},
+		},
+		{
+			name: "circles",
+			input: input{
+				a:  mosaic.NewCircle(mosaic.NewVector(0, 0), 1),
+				da: mosaic.NewVector(10, 0),
+				b:  mosaic.NewCircle(mosaic.NewVector(6, 0), 1),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.4,
+				Normal: mosaic.NewVector(1, 0),
+			},
+		},
+		{
+			name: "circle into a face",
+			input: input{
+				a:  mosaic.NewCircle(mosaic.NewVector(0, 10), 1),
+				da: mosaic.NewVector(0, -20),
+				b:  mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4).ToPolygon(),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.35,
+				Normal: mosaic.NewVector(0, -1),
+			},
+		},
+		{
+			name: "circle into a corner",
+			input: input{
+				a:  mosaic.NewCircle(mosaic.NewVector(2.5, 10), 1),
+				da: mosaic.NewVector(0, -10),
+				b:  mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4).ToPolygon(),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   (8 - math.Sqrt(0.75)) / 10,
+				Normal: mosaic.NewVector(-0.5, -math.Sqrt(0.75)),
+			},
+		},
+		{
+			name: "polygon onto a circle",
+			input: input{
+				a:  mosaic.NewRectangle(mosaic.NewVector(0, 0), 4, 4),
+				da: mosaic.NewVector(0, 0),
+				b:  mosaic.NewCircle(mosaic.NewVector(0, 10), 1),
+				db: mosaic.NewVector(0, -10),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.7,
+				Normal: mosaic.NewVector(0, 1),
+			},
+		},
+		{
+			name: "circle into a compound notch",
+			input: input{
+				a:  mosaic.NewCircle(mosaic.NewVector(3, 10), 1),
+				da: mosaic.NewVector(0, -10),
+				b:  mosaic.NewCompound(l),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.8,
+				Normal: mosaic.NewVector(0, -1),
+			},
+		},
+		{
+			name: "compound onto a circle",
+			input: input{
+				a:  mosaic.NewCompound(l),
+				da: mosaic.NewVector(0, 10),
+				b:  mosaic.NewCircle(mosaic.NewVector(3, 10), 1),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.8,
+				Normal: mosaic.NewVector(0, 1),
+			},
+		},
+		{
+			name: "circle onto a region",
+			input: input{
+				a:  mosaic.NewCircle(mosaic.NewVector(0, 10), 1),
+				da: mosaic.NewVector(0, -20),
+				b:  mosaic.NewRegion(square(0, 0, 8), square(0, 0, 4)),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.25,
+				Normal: mosaic.NewVector(0, -1),
+			},
+		},
+		{
+			name: "circle across a region hole",
+			input: input{
+				a:  mosaic.NewCircle(mosaic.NewVector(0, 0), 0.5),
+				da: mosaic.NewVector(10, 0),
+				b:  mosaic.NewRegion(square(0, 0, 8), square(0, 0, 4)),
+				db: mosaic.NewVector(0, 0),
+			},
+			want: mosaic.Impact{
+				Hit:    true,
+				Time:   0.15,
+				Normal: mosaic.NewVector(1, 0),
+			},
+		},
+	}
+	tolerance := 1e-9
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.Sweep(tt.input.a, tt.input.da, tt.input.b, tt.input.db)
+
+			if got.Hit != tt.want.Hit {
+				t.Fatalf("mosaic.Sweep() hit = %v, want %v", got.Hit, tt.want.Hit)
+			}
+
+			if math.Abs(got.Time-tt.want.Time) > tolerance {
+				t.Errorf("mosaic.Sweep() time = %v, want %v", got.Time, tt.want.Time)
+			}
+
+			if math.Abs(got.Normal.X-tt.want.Normal.X) > tolerance ||
+				math.Abs(got.Normal.Y-tt.want.Normal.Y) > tolerance {
+				t.Errorf("mosaic.Sweep() normal = %v, want %v", got.Normal, tt.want.Normal)
+			}
+		})
+	}
+}
@@ -37,3 +37,66 @@ func NewTransform(x, y, scale, angle float64) Transform {
 		y:     y,
 	}
 }
+
+func (t Transform) Position() Vector {
+	return Vector{X: t.x, Y: t.y}
+}
+
+// Angle is in degrees within (-180, 180]
+func (t Transform) Angle() float64 {
+	return math.Atan2(t.sin, t.cos) * 180.0 / math.Pi
+}
+
+func (t Transform) Scale() float64 {
+	return t.scale
+}
+
+// Compose returns the transform that applies u first and then t
+func (t Transform) Compose(u Transform) Transform {
+	return Transform{
+		x:     t.scale*(t.cos*u.x-t.sin*u.y) + t.x,
+		y:     t.scale*(t.sin*u.x+t.cos*u.y) + t.y,
+		scale: t.scale * u.scale,
+		sin:   t.sin*u.cos + t.cos*u.sin,
+		cos:   t.cos*u.cos - t.sin*u.sin,
+	}
+}
+
+// Inverse undoes t, which needs a non-zero scale as NewTransform guarantees
+func (t Transform) Inverse() Transform {
+	scale := 1 / t.scale
+
+	return Transform{
+		x:     -scale * (t.cos*t.x + t.sin*t.y),
+		y:     -scale * (-t.sin*t.x + t.cos*t.y),
+		scale: scale,
+		sin:   -t.sin,
+		cos:   t.cos,
+	}
+}
+
+// Lerp blends position and scale linearly and turns the rotation along the
+// shorter arc, a fraction of 0 gives t and 1 gives u
+func (t Transform) Lerp(u Transform, fraction float64) Transform {
+	from := math.Atan2(t.sin, t.cos)
+	delta := math.Remainder(math.Atan2(u.sin, u.cos)-from, 2*math.Pi)
+	angle := from + delta*fraction
+
+	return Transform{
+		x:     t.x + (u.x-t.x)*fraction,
+		y:     t.y + (u.y-t.y)*fraction,
+		scale: t.scale + (u.scale-t.scale)*fraction,
+		sin:   math.Sin(angle),
+		cos:   math.Cos(angle),
+	}
+}
+
+// Apply transforms every vector into a new slice
+func (t Transform) Apply(vectors []Vector) []Vector {
+	result := make([]Vector, len(vectors))
+	for i, v := range vectors {
+		result[i] = v.Transform(t)
+	}
+
+	return result
+}
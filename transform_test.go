@@ -0,0 +1,198 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_transform_Accessors(t *testing.T) {
+	type input struct {
+		x, y, scale, angle float64
+	}
+	type want struct {
+		position mosaic.Vector
+		scale    float64
+		angle    float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "identity",
+			input: input{x: 0, y: 0, scale: 1, angle: 0},
+			want:  want{position: mosaic.NewVector(0, 0), scale: 1, angle: 0},
+		},
+		{
+			name:  "zero scale defaults to one",
+			input: input{x: 2, y: -3, scale: 0, angle: 90},
+			want:  want{position: mosaic.NewVector(2, -3), scale: 1, angle: 90},
+		},
+		{
+			name:  "angles wrap",
+			input: input{x: 1, y: 1, scale: 2, angle: 270},
+			want:  want{position: mosaic.NewVector(1, 1), scale: 2, angle: -90},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.NewTransform(tt.input.x, tt.input.y, tt.input.scale, tt.input.angle)
+
+			if got.Position() != tt.want.position {
+				t.Errorf("transform.Position() got = %v, want %v", got.Position(), tt.want.position)
+			}
+
+			if got.Scale() != tt.want.scale {
+				t.Errorf("transform.Scale() got = %v, want %v", got.Scale(), tt.want.scale)
+			}
+
+			if math.Abs(got.Angle()-tt.want.angle) > 0.0001 {
+				t.Errorf("transform.Angle() got = %v, want %v", got.Angle(), tt.want.angle)
+			}
+		})
+	}
+}
+
+func Test_transform_Compose(t *testing.T) {
+	type input struct {
+		t mosaic.Transform
+		u mosaic.Transform
+	}
+	tests := []struct {
+		name  string
+		input input
+	}{
+		{
+			name: "rotate and translate",
+			input: input{
+				t: mosaic.NewTransform(1, 2, 1, 90),
+				u: mosaic.NewTransform(-3, 0, 1, 45),
+			},
+		},
+		{
+			name: "scaled",
+			input: input{
+				t: mosaic.NewTransform(0.5, -1, 2, 30),
+				u: mosaic.NewTransform(4, 4, 0.25, -120),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.t.Compose(tt.input.u)
+
+			for _, v := range []mosaic.Vector{mosaic.NewVector(0, 0), mosaic.NewVector(1, -2), mosaic.NewVector(3, 5)} {
+				want := v.Transform(tt.input.u).Transform(tt.input.t)
+				if !vectorWithinTolerance(v.Transform(got), want, 0.0001) {
+					t.Errorf("transform.Compose() maps %v to %v, want %v", v, v.Transform(got), want)
+				}
+
+				back := v.Transform(got).Transform(got.Inverse())
+				if !vectorWithinTolerance(back, v, 0.0001) {
+					t.Errorf("transform.Inverse() maps back to %v, want %v", back, v)
+				}
+			}
+		})
+	}
+}
+
+func Test_transform_Lerp(t *testing.T) {
+	type input struct {
+		t        mosaic.Transform
+		u        mosaic.Transform
+		fraction float64
+	}
+	type want struct {
+		position mosaic.Vector
+		scale    float64
+		angle    float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "halfway",
+			input: input{
+				t:        mosaic.NewTransform(0, 0, 1, 0),
+				u:        mosaic.NewTransform(4, 2, 3, 90),
+				fraction: 0.5,
+			},
+			want: want{position: mosaic.NewVector(2, 1), scale: 2, angle: 45},
+		},
+		{
+			name: "shortest arc across 180",
+			input: input{
+				t:        mosaic.NewTransform(0, 0, 1, 170),
+				u:        mosaic.NewTransform(0, 0, 1, -170),
+				fraction: 0.5,
+			},
+			want: want{position: mosaic.NewVector(0, 0), scale: 1, angle: 180},
+		},
+		{
+			name: "shortest arc backwards",
+			input: input{
+				t:        mosaic.NewTransform(0, 0, 1, 10),
+				u:        mosaic.NewTransform(0, 0, 1, 300),
+				fraction: 0.25,
+			},
+			want: want{position: mosaic.NewVector(0, 0), scale: 1, angle: -7.5},
+		},
+		{
+			name: "end",
+			input: input{
+				t:        mosaic.NewTransform(0, 0, 1, 0),
+				u:        mosaic.NewTransform(1, 1, 2, 60),
+				fraction: 1,
+			},
+			want: want{position: mosaic.NewVector(1, 1), scale: 2, angle: 60},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.t.Lerp(tt.input.u, tt.input.fraction)
+
+			if !vectorWithinTolerance(got.Position(), tt.want.position, 0.0001) {
+				t.Errorf("transform.Lerp() position = %v, want %v", got.Position(), tt.want.position)
+			}
+
+			if math.Abs(got.Scale()-tt.want.scale) > 0.0001 {
+				t.Errorf("transform.Lerp() scale = %v, want %v", got.Scale(), tt.want.scale)
+			}
+
+			// 180 and -180 are the same angle
+			delta := math.Remainder(got.Angle()-tt.want.angle, 360)
+			if math.Abs(delta) > 0.0001 {
+				t.Errorf("transform.Lerp() angle = %v, want %v", got.Angle(), tt.want.angle)
+			}
+		})
+	}
+}
+
+func Test_transform_Apply(t *testing.T) {
+	vectors := []mosaic.Vector{mosaic.NewVector(1, 0), mosaic.NewVector(0, 1)}
+	tr := mosaic.NewTransform(1, 1, 2, 90)
+
+	got := tr.Apply(vectors)
+	want := []mosaic.Vector{mosaic.NewVector(1, 3), mosaic.NewVector(-1, 1)}
+	for i := range want {
+		if !vectorWithinTolerance(got[i], want[i], 0.0001) {
+			t.Errorf("transform.Apply() got = %v, want %v", got[i], want[i])
+		}
+	}
+
+	if vectors[0] != mosaic.NewVector(1, 0) {
+		t.Errorf("transform.Apply() changed its input")
+	}
+
+	matrix := tr.Matrix().Apply(vectors)
+	for i := range want {
+		if !vectorWithinTolerance(matrix[i], want[i], 0.0001) {
+			t.Errorf("matrix.Apply() got = %v, want %v", matrix[i], want[i])
+		}
+	}
+}
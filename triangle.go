@@ -1,10 +1,200 @@
 package mosaic
 
+import "math"
+
 type (
-	Triangle struct {
-		Position Vector
-		Rotation float64
-		rawEdges [3]Edge
-		Edges    [3]Edge
+	TriangleOf[T Float] struct {
+		Position VectorOf[T]
+		Rotation T
+		rawEdges [3]EdgeOf[T]
+		Edges    [3]EdgeOf[T]
+		Planes   [3]PlaneOf[T]
 	}
+
+	Triangle = TriangleOf[float64]
 )
+
+// NewTriangle accepts three vectors relative to position in CCW rotation
+func NewTriangle[T Float](position, a, b, c VectorOf[T]) TriangleOf[T] {
+	return TriangleOf[T]{
+		Position: position,
+		rawEdges: [3]EdgeOf[T]{
+			{Start: a, End: b, Active: true},
+			{Start: b, End: c, Active: true},
+			{Start: c, End: a, Active: true},
+		},
+	}.Update()
+}
+
+// ConvertTriangle changes the precision of t
+func ConvertTriangle[U, T Float](t TriangleOf[T]) TriangleOf[U] {
+	u := TriangleOf[U]{
+		Position: ConvertVector[U](t.Position),
+		Rotation: U(t.Rotation),
+	}
+	for i := range t.rawEdges {
+		u.rawEdges[i] = ConvertEdge[U](t.rawEdges[i])
+	}
+
+	return u.Update()
+}
+
+// ConvertTriangles changes the precision of every triangle into a new slice,
+// a slice that is already in U is returned as is
+func ConvertTriangles[U, T Float](triangles []TriangleOf[T]) []TriangleOf[U] {
+	if same, ok := any(triangles).([]TriangleOf[U]); ok {
+		return same
+	}
+
+	result := make([]TriangleOf[U], len(triangles))
+	for i, t := range triangles {
+		result[i] = ConvertTriangle[U](t)
+	}
+
+	return result
+}
+
+func (t TriangleOf[T]) Update() TriangleOf[T] {
+	rotation := NewTransform(0, 0, 1, float64(t.Rotation))
+	for i := 0; i < 3; i++ {
+		t.Edges[i].Start = t.Position.Add(t.rawEdges[i].Start.Transform(rotation))
+		t.Edges[i].End = t.Position.Add(t.rawEdges[i].End.Transform(rotation))
+		t.Edges[i].Active = t.rawEdges[i].Active
+
+		if t.Edges[i].Active {
+			t.Planes[i] = NewPlane(t.Edges[i].Start, t.Edges[i].End)
+		}
+	}
+
+	return t
+}
+
+func (t TriangleOf[T]) Type() ShapeType {
+	return TriangleShape
+}
+
+func (t TriangleOf[T]) Bounds() RectangleOf[T] {
+	return edgeBounds(t.Edges[:])
+}
+
+// Transform moves t by the translation of tr, then turns it about its
+// Position by the rotation of tr and scales it
+func (t TriangleOf[T]) Transform(tr Transform) TriangleOf[T] {
+	t.Position = t.Position.Add(ConvertVector[T](tr.Position()))
+	t.Rotation = T(math.Remainder(float64(t.Rotation)+tr.Angle(), 360))
+	copy(t.rawEdges[:], scaleEdges(t.rawEdges[:], T(tr.Scale())))
+
+	return t.Update()
+}
+
+// Rotate turns t about its Position by angle in degrees
+func (t TriangleOf[T]) Rotate(angle T) TriangleOf[T] {
+	return t.SetRotation(t.Rotation + angle)
+}
+
+// SetRotation sets the angle in degrees t is turned by about its Position
+func (t TriangleOf[T]) SetRotation(angle T) TriangleOf[T] {
+	t.Rotation = T(math.Remainder(float64(angle), 360))
+	return t.Update()
+}
+
+// Scale grows t about its Position
+func (t TriangleOf[T]) Scale(c T) TriangleOf[T] {
+	copy(t.rawEdges[:], scaleEdges(t.rawEdges[:], c))
+	return t.Update()
+}
+
+func (t TriangleOf[T]) Area() T {
+	ab := t.Edges[0].End.Subtract(t.Edges[0].Start)
+	ac := t.Edges[2].Start.Subtract(t.Edges[0].Start)
+
+	return T(math.Abs(float64(ab.CrossProduct(ac)))) / 2
+}
+
+// ContainsVector uses barycentric coordinates, points on an edge are contained
+func (t TriangleOf[T]) ContainsVector(v VectorOf[T]) bool {
+	a := t.Edges[0].Start
+	v0 := t.Edges[2].Start.Subtract(a)
+	v1 := t.Edges[1].Start.Subtract(a)
+	v2 := v.Subtract(a)
+
+	dot00 := v0.DotProduct(v0)
+	dot01 := v0.DotProduct(v1)
+	dot02 := v0.DotProduct(v2)
+	dot11 := v1.DotProduct(v1)
+	dot12 := v1.DotProduct(v2)
+
+	denominator := dot00*dot11 - dot01*dot01
+	if denominator == 0 {
+		return false
+	}
+
+	u := (dot11*dot02 - dot01*dot12) / denominator
+	w := (dot00*dot12 - dot01*dot02) / denominator
+
+	return u >= 0 && w >= 0 && u+w <= 1
+}
+
+func (t TriangleOf[T]) Intersects(u TriangleOf[T]) (normal VectorOf[T], depth T) {
+	depth = T(math.Inf(1))
+
+	for _, plane := range t.Planes {
+		minP, maxP := t.projectVectors(plane.Normal)
+		minQ, maxQ := u.projectVectors(plane.Normal)
+
+		if minP >= maxQ || minQ >= maxP {
+			return VectorOf[T]{}, 0.0
+		}
+
+		planeDistance := min(maxQ-minP, maxP-minQ)
+		if planeDistance < depth {
+			depth = planeDistance
+			normal = plane.Normal
+		}
+	}
+
+	for _, plane := range u.Planes {
+		minP, maxP := t.projectVectors(plane.Normal)
+		minQ, maxQ := u.projectVectors(plane.Normal)
+
+		if minP >= maxQ || minQ >= maxP {
+			return VectorOf[T]{}, 0.0
+		}
+
+		planeDistance := min(maxQ-minP, maxP-minQ)
+		if planeDistance < depth {
+			depth = planeDistance
+			normal = plane.Normal
+		}
+	}
+
+	if normal.DotProduct(u.Position.Subtract(t.Position)) < 0 {
+		normal = normal.Invert()
+	}
+
+	return normal, depth
+}
+
+// CastRay returns the closest hit against the active edges
+func (t TriangleOf[T]) CastRay(ray Ray) RayHit {
+	return castRayEdges(t.Edges[:], ray)
+}
+
+// Support returns the vertex furthest along direction
+func (t TriangleOf[T]) Support(direction VectorOf[T]) VectorOf[T] {
+	return supportEdges(t.Edges[:], direction)
+}
+
+func (t TriangleOf[T]) projectVectors(axis VectorOf[T]) (min, max T) {
+	return projectEdges(t.Edges[:], axis)
+}
+
+func (t TriangleOf[T]) ToPolygon() PolygonOf[T] {
+	return NewPolygon(
+		t.Position,
+		[]VectorOf[T]{
+			t.rawEdges[0].Start,
+			t.rawEdges[1].Start,
+			t.rawEdges[2].Start,
+		}).SetRotation(t.Rotation)
+}
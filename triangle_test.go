@@ -0,0 +1,230 @@
+package mosaic_test
+
+import (
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_triangle_ContainsVector(t *testing.T) {
+	type setup struct {
+		triangle mosaic.Triangle
+	}
+	type input struct {
+		vector mosaic.Vector
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		input input
+		want  bool
+	}{
+		{
+			name: "inside",
+			setup: setup{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(10, 10),
+					mosaic.NewVector(-5, -5),
+					mosaic.NewVector(5, -5),
+					mosaic.NewVector(0, 5),
+				),
+			},
+			input: input{vector: mosaic.NewVector(10, 10)},
+			want:  true,
+		},
+		{
+			name: "outside",
+			setup: setup{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(10, 10),
+					mosaic.NewVector(-5, -5),
+					mosaic.NewVector(5, -5),
+					mosaic.NewVector(0, 5),
+				),
+			},
+			input: input{vector: mosaic.NewVector(14, 14)},
+			want:  false,
+		},
+		{
+			name: "on vertex",
+			setup: setup{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(10, 10),
+					mosaic.NewVector(-5, -5),
+					mosaic.NewVector(5, -5),
+					mosaic.NewVector(0, 5),
+				),
+			},
+			input: input{vector: mosaic.NewVector(5, 5)},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.setup.triangle.ContainsVector(tt.input.vector)
+			if got != tt.want {
+				t.Errorf("triangle.ContainsVector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_triangle_Intersects(t *testing.T) {
+	type setup struct {
+		triangle mosaic.Triangle
+	}
+	type input struct {
+		triangle mosaic.Triangle
+	}
+	type want struct {
+		normal mosaic.Vector
+		depth  float64
+	}
+	tests := []struct {
+		name  string
+		setup setup
+		input input
+		want  want
+	}{
+		{
+			name: "overlap",
+			setup: setup{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(4, 0),
+					mosaic.NewVector(0, 4),
+				),
+			},
+			input: input{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(0, 3),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(4, 0),
+					mosaic.NewVector(0, 4),
+				),
+			},
+			want: want{
+				normal: mosaic.Vector{X: 0.7071067811865475, Y: 0.7071067811865475},
+				depth:  0.7071067811865475,
+			},
+		},
+		{
+			name: "apart",
+			setup: setup{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(4, 0),
+					mosaic.NewVector(0, 4),
+				),
+			},
+			input: input{
+				triangle: mosaic.NewTriangle(
+					mosaic.NewVector(3, 3),
+					mosaic.NewVector(0, 0),
+					mosaic.NewVector(4, 0),
+					mosaic.NewVector(0, 4),
+				),
+			},
+			want: want{
+				normal: mosaic.Vector{},
+				depth:  0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := struct {
+				normal mosaic.Vector
+				depth  float64
+			}{}
+			got.normal, got.depth = tt.setup.triangle.Intersects(tt.input.triangle)
+
+			if got.normal != tt.want.normal {
+				t.Errorf("triangle.Intersects() normal = %v, want %v", got.normal, tt.want.normal)
+			}
+
+			if got.depth != tt.want.depth {
+				t.Errorf("triangle.Intersects() depth = %v, want %v", got.depth, tt.want.depth)
+			}
+		})
+	}
+}
+
+func Test_triangle_Area(t *testing.T) {
+	tests := []struct {
+		name      string
+		triangle  mosaic.Triangle
+		transform mosaic.Transform
+		want      float64
+	}{
+		{
+			name: "base case",
+			triangle: mosaic.NewTriangle(
+				mosaic.NewVector(1, 1),
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(4, 0),
+				mosaic.NewVector(0, 4),
+			),
+			transform: mosaic.NewTransform(0, 0, 0, 0),
+			want:      8,
+		},
+		{
+			name: "transform scale",
+			triangle: mosaic.NewTriangle(
+				mosaic.NewVector(1, 1),
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(4, 0),
+				mosaic.NewVector(0, 4),
+			),
+			transform: mosaic.NewTransform(3, 3, 2, 0),
+			want:      32,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.triangle.Transform(tt.transform).Area()
+			if got != tt.want {
+				t.Errorf("triangle.Area() = %v, want %v", got, tt.want)
+			}
+
+			got = tt.triangle.Transform(tt.transform).ToPolygon().Area()
+			if got != tt.want {
+				t.Errorf("triangle.ToPolygon().Area() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_triangle_Transform(t *testing.T) {
+	tri := mosaic.NewTriangle(
+		mosaic.NewVector(1, 1),
+		mosaic.NewVector(0, 0),
+		mosaic.NewVector(2, 0),
+		mosaic.NewVector(0, 2),
+	)
+
+	got := tri.Transform(mosaic.NewTransform(1, 0, 2, 90))
+	if got.Position != mosaic.NewVector(2, 1) || got.Rotation != 90 {
+		t.Errorf("triangle.Transform() got = %v %v, want %v %v", got.Position, got.Rotation, mosaic.NewVector(2, 1), 90)
+	}
+
+	want := []mosaic.Vector{mosaic.NewVector(2, 1), mosaic.NewVector(2, 5), mosaic.NewVector(-2, 1)}
+	for i := range want {
+		if !vectorWithinTolerance(got.Edges[i].Start, want[i], 0.0001) {
+			t.Errorf("triangle.Transform() vertex %d = %v, want %v", i, got.Edges[i].Start, want[i])
+		}
+	}
+
+	polygon := got.ToPolygon()
+	for i := range want {
+		if !vectorWithinTolerance(polygon.Edges[i].Start, want[i], 0.0001) {
+			t.Errorf("triangle.ToPolygon() vertex %d = %v, want %v", i, polygon.Edges[i].Start, want[i])
+		}
+	}
+
+	if !WithinTolerance(got.Area(), 8, 0.0001) {
+		t.Errorf("triangle.Transform() area = %v, want %v", got.Area(), 8)
+	}
+}
@@ -0,0 +1,269 @@
+package mosaic
+
+import (
+	"math"
+	"sort"
+)
+
+// Triangulate splits p into CCW triangles sharing p's Position and Rotation
+// by ear clipping, the area covered by holes is left out
+func (p PolygonOf[T]) Triangulate(holes ...PolygonOf[T]) []TriangleOf[T] {
+	outer := make([]Vector, len(p.rawEdges))
+	for i, edge := range p.rawEdges {
+		outer[i] = ConvertVector[float64](edge.Start)
+	}
+
+	rings := make([][]Vector, len(holes))
+	for i, hole := range holes {
+		rings[i] = make([]Vector, len(hole.Edges))
+		for j, edge := range hole.Edges {
+			rings[i][j] = ConvertVector[float64](p.toLocal(edge.Start))
+		}
+	}
+
+	vectors := append([]Vector{}, outer...)
+	for _, ring := range rings {
+		vectors = append(vectors, ring...)
+	}
+
+	indices := TriangulateIndices(outer, rings...)
+	triangles := make([]TriangleOf[T], len(indices))
+	for i, index := range indices {
+		triangles[i] = NewTriangle(
+			p.Position,
+			ConvertVector[T](vectors[index[0]]),
+			ConvertVector[T](vectors[index[1]]),
+			ConvertVector[T](vectors[index[2]]),
+		).SetRotation(p.Rotation)
+	}
+
+	return triangles
+}
+
+// TriangulateIndices ear clips a simple polygon with holes. Each triple
+// indexes the outer vectors followed by the vectors of every hole in order
+// and is wound CCW.
+func TriangulateIndices(outer []Vector, holes ...[]Vector) [][3]int {
+	vectors := append([]Vector{}, outer...)
+	ring := make([]int, len(outer))
+	for i := range ring {
+		ring[i] = i
+	}
+	if signedArea(outer) < 0 {
+		reverseIndices(ring)
+	}
+
+	type hole struct {
+		indices   []int
+		rightmost int
+	}
+	bridges := make([]hole, 0, len(holes))
+	for _, h := range holes {
+		if len(h) < 3 {
+			continue
+		}
+
+		indices := make([]int, len(h))
+		for i := range h {
+			indices[i] = len(vectors)
+			vectors = append(vectors, h[i])
+		}
+
+		// Holes wind against the outer ring
+		if signedArea(h) > 0 {
+			reverseIndices(indices)
+		}
+
+		rightmost := 0
+		for i := range indices {
+			if vectors[indices[i]].X > vectors[indices[rightmost]].X {
+				rightmost = i
+			}
+		}
+
+		bridges = append(bridges, hole{indices: indices, rightmost: rightmost})
+	}
+
+	sort.SliceStable(bridges, func(i, j int) bool {
+		return vectors[bridges[i].indices[bridges[i].rightmost]].X >
+			vectors[bridges[j].indices[bridges[j].rightmost]].X
+	})
+
+	for _, h := range bridges {
+		ring = bridgeHole(vectors, ring, h.indices, h.rightmost)
+	}
+
+	return earClip(vectors, ring)
+}
+
+// bridgeHole joins the hole into the ring with a pair of coincident edges
+// between the hole's rightmost vertex and a vertex of the ring it can see
+func bridgeHole(vectors []Vector, ring, hole []int, rightmost int) []int {
+	m := vectors[hole[rightmost]]
+	n := len(ring)
+
+	// Cast a ray from m towards +x and find the closest edge it hits
+	closest := math.MaxFloat64
+	edge := -1
+	for k := 0; k < n; k++ {
+		a := vectors[ring[k]]
+		b := vectors[ring[(k+1)%n]]
+
+		if a.Y == b.Y || min(a.Y, b.Y) > m.Y || max(a.Y, b.Y) < m.Y {
+			continue
+		}
+
+		x := a.X + (m.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+		if x >= m.X && x < closest {
+			closest = x
+			edge = k
+		}
+	}
+
+	if edge < 0 {
+		return ring
+	}
+
+	intersect := NewVector(closest, m.Y)
+	visible := edge
+	if vectors[ring[(edge+1)%n]].X > vectors[ring[edge]].X {
+		visible = (edge + 1) % n
+	}
+
+	if vectors[ring[visible]] != intersect {
+		// A reflex vertex inside the triangle m, intersect, visible would
+		// block the bridge, use the one closest in angle to the ray instead
+		p := vectors[ring[visible]]
+		bestAngle := math.MaxFloat64
+		bestDistance := math.MaxFloat64
+		for k := 0; k < n; k++ {
+			v := vectors[ring[k]]
+			prev := vectors[ring[(k-1+n)%n]]
+			next := vectors[ring[(k+1)%n]]
+
+			if k == visible || !isReflex(prev, v, next) || !inTriangle(v, m, intersect, p) {
+				continue
+			}
+
+			offset := v.Subtract(m)
+			angle := math.Abs(math.Atan2(offset.Y, offset.X))
+			distance := offset.Length()
+			if angle < bestAngle || (angle == bestAngle && distance < bestDistance) {
+				bestAngle = angle
+				bestDistance = distance
+				visible = k
+			}
+		}
+	}
+
+	// The same vertex may already be on the ring twice, pick the copy whose
+	// interior wedge faces the hole
+	for k := 0; k < n; k++ {
+		if vectors[ring[k]] != vectors[ring[visible]] {
+			continue
+		}
+
+		prev := vectors[ring[(k-1+n)%n]]
+		next := vectors[ring[(k+1)%n]]
+		if inCone(prev, vectors[ring[k]], next, m) {
+			visible = k
+			break
+		}
+	}
+
+	bridged := make([]int, 0, n+len(hole)+2)
+	bridged = append(bridged, ring[:visible+1]...)
+	for k := 0; k <= len(hole); k++ {
+		bridged = append(bridged, hole[(rightmost+k)%len(hole)])
+	}
+	bridged = append(bridged, ring[visible])
+	bridged = append(bridged, ring[visible+1:]...)
+
+	return bridged
+}
+
+func earClip(vectors []Vector, ring []int) [][3]int {
+	triangles := make([][3]int, 0, len(ring))
+
+	for len(ring) > 3 {
+		n := len(ring)
+		clipped := false
+
+		for i := 0; i < n; i++ {
+			prev := ring[(i-1+n)%n]
+			next := ring[(i+1)%n]
+			a, b, c := vectors[prev], vectors[ring[i]], vectors[next]
+
+			if collinear(a, b, c) {
+				ring = append(ring[:i], ring[i+1:]...)
+				clipped = true
+				break
+			}
+
+			if turn(a, b, c) < 0 || !isEar(vectors, ring, a, b, c) {
+				continue
+			}
+
+			triangles = append(triangles, [3]int{prev, ring[i], next})
+			ring = append(ring[:i], ring[i+1:]...)
+			clipped = true
+			break
+		}
+
+		if !clipped {
+			break
+		}
+	}
+
+	if len(ring) == 3 {
+		a, b, c := vectors[ring[0]], vectors[ring[1]], vectors[ring[2]]
+		if !collinear(a, b, c) && turn(a, b, c) > 0 {
+			triangles = append(triangles, [3]int{ring[0], ring[1], ring[2]})
+		}
+	}
+
+	return triangles
+}
+
+// isEar reports whether no other vertex of the ring falls inside a, b, c
+func isEar(vectors []Vector, ring []int, a, b, c Vector) bool {
+	for _, index := range ring {
+		v := vectors[index]
+		if v == a || v == b || v == c {
+			continue
+		}
+
+		if inTriangle(v, a, b, c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// inTriangle includes the boundary and accepts either winding
+func inTriangle(v, a, b, c Vector) bool {
+	d1 := turn(a, b, v)
+	d2 := turn(b, c, v)
+	d3 := turn(c, a, v)
+
+	negative := d1 < 0 || d2 < 0 || d3 < 0
+	positive := d1 > 0 || d2 > 0 || d3 > 0
+
+	return !(negative && positive)
+}
+
+// inCone reports whether v lies in the interior wedge at vertex b of a CCW ring
+func inCone(a, b, c, v Vector) bool {
+	if turn(a, b, c) >= 0 {
+		return turn(a, b, v) >= 0 && turn(b, c, v) >= 0
+	}
+
+	return turn(a, b, v) >= 0 || turn(b, c, v) >= 0
+}
+
+func reverseIndices(indices []int) {
+	for i, j := 0, len(indices)-1; i < j; i, j = i+1, j-1 {
+		indices[i], indices[j] = indices[j], indices[i]
+	}
+}
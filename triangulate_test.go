@@ -0,0 +1,99 @@
+package mosaic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_polygon_Triangulate(t *testing.T) {
+	type input struct {
+		polygon mosaic.Polygon
+		holes   []mosaic.Polygon
+	}
+	type want struct {
+		triangles int
+		area      float64
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "square",
+			input: input{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(5, 5), 4, 4).ToPolygon(),
+			},
+			want: want{triangles: 2, area: 16},
+		},
+		{
+			name: "L shape",
+			input: input{
+				polygon: mosaic.NewPolygon(
+					mosaic.NewVector(0, 0),
+					[]mosaic.Vector{
+						mosaic.NewVector(0, 0),
+						mosaic.NewVector(4, 0),
+						mosaic.NewVector(4, 1),
+						mosaic.NewVector(1, 1),
+						mosaic.NewVector(1, 4),
+						mosaic.NewVector(0, 4),
+					},
+				),
+			},
+			want: want{triangles: 4, area: 7},
+		},
+		{
+			name: "square with a hole",
+			input: input{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 10, 10).ToPolygon(),
+				holes: []mosaic.Polygon{
+					mosaic.NewRectangle(mosaic.NewVector(1, 1), 2, 2).ToPolygon(),
+				},
+			},
+			want: want{triangles: 8, area: 96},
+		},
+		{
+			name: "square with two holes",
+			input: input{
+				polygon: mosaic.NewRectangle(mosaic.NewVector(0, 0), 10, 10).ToPolygon(),
+				holes: []mosaic.Polygon{
+					mosaic.NewRectangle(mosaic.NewVector(-2, 2), 2, 2).ToPolygon(),
+					mosaic.NewRectangle(mosaic.NewVector(2, -2), 2, 2).ToPolygon(),
+				},
+			},
+			want: want{triangles: 14, area: 92},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.polygon.Triangulate(tt.input.holes...)
+
+			if len(got) != tt.want.triangles {
+				t.Errorf("polygon.Triangulate() triangles = %v, want %v", len(got), tt.want.triangles)
+			}
+
+			area := 0.0
+			for _, triangle := range got {
+				if triangle.Position != tt.input.polygon.Position {
+					t.Errorf("polygon.Triangulate() position = %v, want %v", triangle.Position, tt.input.polygon.Position)
+				}
+
+				a := triangle.Edges[0].Start
+				b := triangle.Edges[1].Start
+				c := triangle.Edges[2].Start
+				if b.Subtract(a).CrossProduct(c.Subtract(a)) <= 0 {
+					t.Errorf("polygon.Triangulate() triangle %v is not CCW", triangle.Edges)
+				}
+
+				area += triangle.Area()
+			}
+
+			if math.Abs(area-tt.want.area) > 1e-9 {
+				t.Errorf("polygon.Triangulate() area = %v, want %v", area, tt.want.area)
+			}
+		})
+	}
+}
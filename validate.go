@@ -0,0 +1,146 @@
+package mosaic
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTooFewVertices   = errors.New("polygon has fewer than three vertices")
+	ErrDuplicateVertex  = errors.New("polygon has duplicate vertices")
+	ErrCollinearVertex  = errors.New("polygon has collinear vertices")
+	ErrSelfIntersection = errors.New("polygon intersects itself")
+	ErrClockwise        = errors.New("polygon is wound clockwise")
+)
+
+// NewValidPolygon is NewPolygon for untrusted input, it reports the first
+// problem ValidateVectors finds instead of building a broken polygon
+func NewValidPolygon[T Float](position VectorOf[T], vectors []VectorOf[T]) (PolygonOf[T], error) {
+	if err := ValidateVectors(vectors); err != nil {
+		return PolygonOf[T]{}, err
+	}
+
+	return NewPolygon(position, vectors), nil
+}
+
+// ValidateVectors checks that vectors form a simple CCW ring without
+// duplicate or collinear vertices
+func ValidateVectors[T Float](vectors []VectorOf[T]) error {
+	return validateVectors(ConvertVectors[float64](vectors))
+}
+
+func validateVectors(vectors []Vector) error {
+	n := len(vectors)
+	if n < 3 {
+		return fmt.Errorf("%w: got %d", ErrTooFewVertices, n)
+	}
+
+	seen := make(map[Vector]int, n)
+	for i, v := range vectors {
+		if j, ok := seen[v]; ok {
+			return fmt.Errorf("%w: %d and %d at %v", ErrDuplicateVertex, j, i, v)
+		}
+		seen[v] = i
+	}
+
+	for i := 0; i < n; i++ {
+		if collinear(vectors[(i-1+n)%n], vectors[i], vectors[(i+1)%n]) {
+			return fmt.Errorf("%w: %d at %v", ErrCollinearVertex, i, vectors[i])
+		}
+	}
+
+	if i, j, ok := selfIntersection(vectors); ok {
+		return fmt.Errorf("%w: edges %d and %d", ErrSelfIntersection, i, j)
+	}
+
+	if signedArea(vectors) < 0 {
+		return ErrClockwise
+	}
+
+	return nil
+}
+
+// Normalize rewinds p CCW and drops repeated and collinear vertices. A ring
+// that still crosses itself, or that collapses below three vertices, is
+// reported through the error, the cleaned polygon is returned either way.
+func (p PolygonOf[T]) Normalize() (PolygonOf[T], error) {
+	vectors := make([]Vector, 0, len(p.rawEdges))
+	for _, edge := range p.rawEdges {
+		vectors = append(vectors, ConvertVector[float64](edge.Start))
+	}
+
+	vectors = normalizeVectors(vectors)
+	q := p.reshape(ConvertVectors[T](vectors))
+
+	if len(vectors) < 3 {
+		return q, fmt.Errorf("%w: got %d", ErrTooFewVertices, len(vectors))
+	}
+
+	if i, j, ok := selfIntersection(vectors); ok {
+		return q, fmt.Errorf("%w: edges %d and %d", ErrSelfIntersection, i, j)
+	}
+
+	return q, nil
+}
+
+func normalizeVectors(vectors []Vector) []Vector {
+	// Removing a vertex can leave its neighbours collinear in turn
+	for {
+		n := len(vectors)
+		if n < 3 {
+			break
+		}
+
+		vectors = removeCollinear(removeDuplicates(vectors))
+		if len(vectors) == n {
+			break
+		}
+	}
+
+	if len(vectors) < 3 {
+		return vectors
+	}
+
+	if signedArea(vectors) < 0 {
+		reverseVectors(vectors)
+	}
+
+	return vectors
+}
+
+// removeDuplicates drops vertices equal to the one before them
+func removeDuplicates(vectors []Vector) []Vector {
+	result := make([]Vector, 0, len(vectors))
+	for i, v := range vectors {
+		if i > 0 && v == result[len(result)-1] {
+			continue
+		}
+		result = append(result, v)
+	}
+
+	for len(result) > 1 && result[0] == result[len(result)-1] {
+		result = result[:len(result)-1]
+	}
+
+	return result
+}
+
+// selfIntersection finds the first pair of edges that touch without being
+// neighbours in the ring, edge i runs from vertex i to vertex i+1
+func selfIntersection(vectors []Vector) (int, int, bool) {
+	n := len(vectors)
+	for i := 0; i < n; i++ {
+		a, b := vectors[i], vectors[(i+1)%n]
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue
+			}
+
+			if segmentsTouch(a, b, vectors[j], vectors[(j+1)%n]) {
+				return i, j, true
+			}
+		}
+	}
+
+	return 0, 0, false
+}
@@ -0,0 +1,173 @@
+package mosaic_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maladroitthief/mosaic"
+)
+
+func Test_ValidateVectors(t *testing.T) {
+	type input struct {
+		vectors []mosaic.Vector
+	}
+	type want struct {
+		err error
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "valid",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(1, 1),
+				mosaic.NewVector(0, 1),
+			}},
+			want: want{err: nil},
+		},
+		{
+			name: "too few",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 0),
+			}},
+			want: want{err: mosaic.ErrTooFewVertices},
+		},
+		{
+			name: "duplicate",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(0, 1),
+			}},
+			want: want{err: mosaic.ErrDuplicateVertex},
+		},
+		{
+			name: "collinear",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(2, 0),
+				mosaic.NewVector(0, 1),
+			}},
+			want: want{err: mosaic.ErrCollinearVertex},
+		},
+		{
+			name: "bow tie",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 1),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(0, 1),
+			}},
+			want: want{err: mosaic.ErrSelfIntersection},
+		},
+		{
+			name: "clockwise",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(0, 1),
+				mosaic.NewVector(1, 1),
+				mosaic.NewVector(1, 0),
+			}},
+			want: want{err: mosaic.ErrClockwise},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mosaic.ValidateVectors(tt.input.vectors)
+			if !errors.Is(got, tt.want.err) {
+				t.Errorf("mosaic.ValidateVectors() got = %v, want %v", got, tt.want.err)
+			}
+
+			_, err := mosaic.NewValidPolygon(mosaic.Vector{}, tt.input.vectors)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("mosaic.NewValidPolygon() got = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func Test_polygon_Normalize(t *testing.T) {
+	type input struct {
+		vectors []mosaic.Vector
+	}
+	type want struct {
+		vertices int
+		area     float64
+		err      error
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name: "clockwise with duplicates and collinear points",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(0, 2),
+				mosaic.NewVector(1, 2),
+				mosaic.NewVector(2, 2),
+				mosaic.NewVector(2, 0),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(0, 0),
+			}},
+			want: want{vertices: 4, area: 4},
+		},
+		{
+			name: "spike",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(2, 0),
+				mosaic.NewVector(3, 0),
+				mosaic.NewVector(2, 0),
+				mosaic.NewVector(2, 2),
+				mosaic.NewVector(0, 2),
+			}},
+			want: want{vertices: 4, area: 4},
+		},
+		{
+			name: "bow tie",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 1),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(0, 1),
+			}},
+			want: want{vertices: 4, area: 0, err: mosaic.ErrSelfIntersection},
+		},
+		{
+			name: "line",
+			input: input{vectors: []mosaic.Vector{
+				mosaic.NewVector(0, 0),
+				mosaic.NewVector(1, 0),
+				mosaic.NewVector(2, 0),
+			}},
+			want: want{vertices: 0, err: mosaic.ErrTooFewVertices},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mosaic.NewPolygon(mosaic.NewVector(1, 1), tt.input.vectors).Normalize()
+
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("polygon.Normalize() err = %v, want %v", err, tt.want.err)
+			}
+
+			if len(got.Edges) != tt.want.vertices {
+				t.Errorf("polygon.Normalize() vertices = %v, want %v", len(got.Edges), tt.want.vertices)
+			}
+
+			if !WithinTolerance(got.Area(), tt.want.area, 0.0001) {
+				t.Errorf("polygon.Normalize() area = %v, want %v", got.Area(), tt.want.area)
+			}
+		})
+	}
+}
@@ -3,107 +3,138 @@ package mosaic
 import "math"
 
 type (
-	Vector struct {
-		X float64
-		Y float64
+	// Float is the set of coordinate types the geometry core works in
+	Float interface {
+		~float32 | ~float64
 	}
+
+	VectorOf[T Float] struct {
+		X T
+		Y T
+	}
+
+	Vector = VectorOf[float64]
 )
 
 func NewVector(x, y float64) Vector {
 	return Vector{X: x, Y: y}
 }
 
-func (v Vector) Copy(w Vector) Vector {
-	return Vector{X: w.X, Y: w.Y}
+func NewVectorOf[T Float](x, y T) VectorOf[T] {
+	return VectorOf[T]{X: x, Y: y}
+}
+
+// ConvertVector changes the precision of v
+func ConvertVector[U, T Float](v VectorOf[T]) VectorOf[U] {
+	return VectorOf[U]{X: U(v.X), Y: U(v.Y)}
+}
+
+// ConvertVectors changes the precision of every vector into a new slice, a
+// slice that is already in U is returned as is
+func ConvertVectors[U, T Float](vectors []VectorOf[T]) []VectorOf[U] {
+	if same, ok := any(vectors).([]VectorOf[U]); ok {
+		return same
+	}
+
+	result := make([]VectorOf[U], len(vectors))
+	for i, v := range vectors {
+		result[i] = ConvertVector[U](v)
+	}
+
+	return result
+}
+
+func (v VectorOf[T]) Copy(w VectorOf[T]) VectorOf[T] {
+	return VectorOf[T]{X: w.X, Y: w.Y}
 }
 
-func (v Vector) Clone() Vector {
+func (v VectorOf[T]) Clone() VectorOf[T] {
 	return v.Copy(v)
 }
 
-func (v Vector) Perpendicular() Vector {
-	return Vector{X: v.Y, Y: -v.X}
+func (v VectorOf[T]) Perpendicular() VectorOf[T] {
+	return VectorOf[T]{X: v.Y, Y: -v.X}
 }
 
-func (v Vector) Invert() Vector {
-	return Vector{X: -v.X, Y: -v.Y}
+func (v VectorOf[T]) Invert() VectorOf[T] {
+	return VectorOf[T]{X: -v.X, Y: -v.Y}
 }
 
-func (v Vector) DotProduct(w Vector) float64 {
+func (v VectorOf[T]) DotProduct(w VectorOf[T]) T {
 	return (v.X * w.X) + (v.Y * w.Y)
 }
 
-func (v Vector) CrossProduct(w Vector) float64 {
+func (v VectorOf[T]) CrossProduct(w VectorOf[T]) T {
 	return (v.X * w.Y) - (v.Y * w.X)
 }
 
-func (v Vector) Normal(w Vector) Vector {
+func (v VectorOf[T]) Normal(w VectorOf[T]) VectorOf[T] {
 	return v.RightNormal(w)
 }
 
 // For clockwise order
-func (v Vector) LeftNormal(w Vector) Vector {
+func (v VectorOf[T]) LeftNormal(w VectorOf[T]) VectorOf[T] {
 	vn := v.Subtract(w).Normalize()
-	return Vector{
+	return VectorOf[T]{
 		X: -vn.Y,
 		Y: vn.X,
 	}
 }
 
 // For counter clockwise order
-func (v Vector) RightNormal(w Vector) Vector {
+func (v VectorOf[T]) RightNormal(w VectorOf[T]) VectorOf[T] {
 	vn := w.Subtract(v).Normalize()
-	return Vector{
+	return VectorOf[T]{
 		X: vn.Y,
 		Y: -vn.X,
 	}
 }
 
-func (v Vector) Add(w Vector) Vector {
-	return Vector{
+func (v VectorOf[T]) Add(w VectorOf[T]) VectorOf[T] {
+	return VectorOf[T]{
 		X: v.X + w.X,
 		Y: v.Y + w.Y,
 	}
 }
 
-func (v Vector) Subtract(w Vector) Vector {
-	return Vector{
+func (v VectorOf[T]) Subtract(w VectorOf[T]) VectorOf[T] {
+	return VectorOf[T]{
 		X: v.X - w.X,
 		Y: v.Y - w.Y,
 	}
 }
 
-func (v Vector) Scale(c float64) Vector {
-	return Vector{
+func (v VectorOf[T]) Scale(c T) VectorOf[T] {
+	return VectorOf[T]{
 		X: v.X * c,
 		Y: v.Y * c,
 	}
 }
 
-func (v Vector) ScaleXY(cx, cy float64) Vector {
-	return Vector{
+func (v VectorOf[T]) ScaleXY(cx, cy T) VectorOf[T] {
+	return VectorOf[T]{
 		X: v.X * cx,
 		Y: v.Y * cy,
 	}
 }
 
-func (v Vector) Projection(w Vector) Vector {
+func (v VectorOf[T]) Projection(w VectorOf[T]) VectorOf[T] {
 	return w.Scale(v.DotProduct(w) / w.DotProduct(w))
 }
 
-func (v Vector) UnitProjection(w Vector) Vector {
+func (v VectorOf[T]) UnitProjection(w VectorOf[T]) VectorOf[T] {
 	return w.Scale(v.DotProduct(w))
 }
 
-func (v Vector) Reflect(w Vector) Vector {
+func (v VectorOf[T]) Reflect(w VectorOf[T]) VectorOf[T] {
 	return v.Projection(w).Scale(2).Subtract(v)
 }
 
-func (v Vector) UnitReflect(w Vector) Vector {
+func (v VectorOf[T]) UnitReflect(w VectorOf[T]) VectorOf[T] {
 	return v.UnitProjection(w).Scale(2).Subtract(v)
 }
 
-func (v Vector) Normalize() Vector {
+func (v VectorOf[T]) Normalize() VectorOf[T] {
 	c := v.Magnitude()
 	if c == 0 {
 		c = 1
@@ -112,21 +143,22 @@ func (v Vector) Normalize() Vector {
 	return v.Scale(1 / c)
 }
 
-func (v Vector) Length() float64 {
+func (v VectorOf[T]) Length() T {
 	return v.DotProduct(v)
 }
 
-func (v Vector) Magnitude() float64 {
-	return math.Sqrt(v.Length())
+func (v VectorOf[T]) Magnitude() T {
+	return T(math.Sqrt(float64(v.Length())))
 }
 
-func (v Vector) Distance(w Vector) float64 {
-	return math.Sqrt(math.Pow(w.X-v.X, 2) + math.Pow(w.Y-v.Y, 2))
+func (v VectorOf[T]) Distance(w VectorOf[T]) T {
+	return T(math.Sqrt(math.Pow(float64(w.X-v.X), 2) + math.Pow(float64(w.Y-v.Y), 2)))
 }
 
-func (v Vector) Transform(t Transform) Vector {
-	return Vector{
-		X: t.scale*(t.cos*v.X-t.sin*v.Y) + t.x,
-		Y: t.scale*(t.sin*v.X+t.cos*v.Y) + t.y,
+func (v VectorOf[T]) Transform(t Transform) VectorOf[T] {
+	scale, sin, cos := T(t.scale), T(t.sin), T(t.cos)
+	return VectorOf[T]{
+		X: scale*(cos*v.X-sin*v.Y) + T(t.x),
+		Y: scale*(sin*v.X+cos*v.Y) + T(t.y),
 	}
 }
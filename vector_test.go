@@ -1,6 +1,7 @@
 package mosaic_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/maladroitthief/mosaic"
@@ -183,3 +184,80 @@ func Test_vector_Transform(t *testing.T) {
 	}
 
 }
+
+func Test_vectorOf_float32(t *testing.T) {
+	type input struct {
+		v mosaic.VectorOf[float32]
+		w mosaic.VectorOf[float32]
+	}
+	type want struct {
+		sum      mosaic.VectorOf[float32]
+		cross    float32
+		distance float32
+		rotated  mosaic.VectorOf[float32]
+	}
+	tests := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			name:  "base case",
+			input: input{v: mosaic.NewVectorOf[float32](3, 0), w: mosaic.NewVectorOf[float32](0, 4)},
+			want: want{
+				sum:      mosaic.NewVectorOf[float32](3, 4),
+				cross:    12,
+				distance: 5,
+				rotated:  mosaic.NewVectorOf[float32](0, 3),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.v.Add(tt.input.w); got != tt.want.sum {
+				t.Errorf("vector.Add() = %v, want %v", got, tt.want.sum)
+			}
+
+			if got := tt.input.v.CrossProduct(tt.input.w); got != tt.want.cross {
+				t.Errorf("vector.CrossProduct() = %v, want %v", got, tt.want.cross)
+			}
+
+			if got := tt.input.v.Distance(tt.input.w); got != tt.want.distance {
+				t.Errorf("vector.Distance() = %v, want %v", got, tt.want.distance)
+			}
+
+			got := tt.input.v.Transform(mosaic.NewTransform(0, 0, 1, 90))
+			if math.Abs(float64(got.X-tt.want.rotated.X)) > 0.0001 || math.Abs(float64(got.Y-tt.want.rotated.Y)) > 0.0001 {
+				t.Errorf("vector.Transform() = %v, want %v", got, tt.want.rotated)
+			}
+
+			plane := mosaic.NewPlane(tt.input.v, tt.input.w)
+			if d := plane.DistanceTo(mosaic.VectorOf[float32]{}); math.Abs(float64(d+2.4)) > 0.0001 {
+				t.Errorf("plane.DistanceTo() = %v, want %v", d, -2.4)
+			}
+
+			edge := mosaic.EdgeOf[float32]{Start: tt.input.v, End: tt.input.w}
+			closest := edge.ClosestPoint(mosaic.VectorOf[float32]{})
+			if math.Abs(float64(closest.X-1.92)) > 0.0001 || math.Abs(float64(closest.Y-1.44)) > 0.0001 {
+				t.Errorf("edge.ClosestPoint() = %v, want %v", closest, mosaic.NewVectorOf[float32](1.92, 1.44))
+			}
+		})
+	}
+}
+
+func Test_ConvertVectors(t *testing.T) {
+	vectors := []mosaic.Vector{mosaic.NewVector(1.5, -2), mosaic.NewVector(0.1, 3)}
+
+	got := mosaic.ConvertVectors[float32](vectors)
+	want := []mosaic.VectorOf[float32]{{X: 1.5, Y: -2}, {X: 0.1, Y: 3}}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("mosaic.ConvertVectors() = %v, want %v", got[i], want[i])
+		}
+	}
+
+	back := mosaic.ConvertVector[float64](got[0])
+	if back != vectors[0] {
+		t.Errorf("mosaic.ConvertVector() = %v, want %v", back, vectors[0])
+	}
+}